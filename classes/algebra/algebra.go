@@ -1,6 +1,9 @@
 package algebra
 
-import "golang.org/x/exp/constraints"
+import (
+	"github.com/genkami/dogs/classes/cmp"
+	"golang.org/x/exp/constraints"
+)
 
 // Additive is a type that can use `+` operator.
 type Additive interface {
@@ -84,6 +87,22 @@ func (multiplicativeMonoid[T]) Empty() T {
 	return 1
 }
 
+// DeriveOrderingMonoid derives Monoid of cmp.Ordering whose Combine is cmp.Ordering.Then and Empty is cmp.EQ.
+// It is useful to compare values by multiple keys.
+func DeriveOrderingMonoid() Monoid[cmp.Ordering] {
+	return orderingMonoid{}
+}
+
+type orderingMonoid struct{}
+
+func (orderingMonoid) Combine(x, y cmp.Ordering) cmp.Ordering {
+	return x.Then(y)
+}
+
+func (orderingMonoid) Empty() cmp.Ordering {
+	return cmp.EQ
+}
+
 // DefaultMonoid is a default implementation of Monoid.
 type DefaultMonoid[T any] struct {
 	Semigroup[T]
@@ -2,7 +2,12 @@ package algebra_test
 
 import (
 	"github.com/genkami/dogs/classes/algebra"
+	"github.com/genkami/dogs/classes/algebra/algebratest"
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/debug"
 	"github.com/stretchr/testify/assert"
+	"math/rand"
 	"testing"
 )
 
@@ -44,3 +49,19 @@ func TestDeriveMultiplicativeMonoid(t *testing.T) {
 	assert.Equal(t, s2.Combine(s2.Empty(), 8), int32(8))
 	assert.Equal(t, s2.Combine(9, s2.Empty()), int32(9))
 }
+
+func TestDeriveOrderingMonoid(t *testing.T) {
+	m := algebra.DeriveOrderingMonoid()
+	assert.Equal(t, m.Combine(cmp.LT, cmp.GT), cmp.LT)
+	assert.Equal(t, m.Combine(cmp.EQ, cmp.GT), cmp.GT)
+	assert.Equal(t, m.Combine(cmp.GT, cmp.EQ), cmp.GT)
+	assert.Equal(t, m.Combine(cmp.EQ, cmp.EQ), cmp.EQ)
+	assert.Equal(t, m.Empty(), cmp.EQ)
+
+	arb := &arbitrary.DefaultArbitrary[cmp.Ordering]{
+		ChooseImpl: func(r *rand.Rand) cmp.Ordering {
+			return cmp.Ordering(r.Intn(3))
+		},
+	}
+	algebratest.AssertMonoid(t, m, cmp.DeriveEq[cmp.Ordering](), arb, debug.DeriveDebug[cmp.Ordering]())
+}
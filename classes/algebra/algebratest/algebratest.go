@@ -1,4 +1,259 @@
+// Package algebratest provides functions to check that instances of type classes in algebra satisfy their laws.
 package algebratest
 
-// TODO: AssertSemigroup[T any](t *testing.T, s Semigroup[T], eq Eq[T], arb Arbitrary[T], dbg Debug[T])
-// TODO: AssertMonoid[T any](t *testing.T, s Monoid[T], eq Eq[T], arb Arbitrary[T], dbg Debug[T])
+import (
+	"github.com/genkami/dogs/classes/algebra"
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/arbitrary/prop"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/types/pair"
+	"testing"
+)
+
+type triple[T any] = pair.Pair[T, pair.Pair[T, T]]
+
+// AssertSemigroup checks that s satisfies the law of Semigroup using random values generated by arb:
+//
+//	Combine(Combine(x, y), z) == Combine(x, Combine(y, z))
+//
+// It reports a shrunk counterexample formatted by dbg and returns false if the law doesn't hold.
+func AssertSemigroup[T any](t testing.TB, s algebra.Semigroup[T], eq cmp.Eq[T], arb arbitrary.Arbitrary[T], dbg debug.Debug[T]) bool {
+	t.Helper()
+	triples := pair.DerivePairArbitrary(arb, pair.DerivePairArbitrary(arb, arb))
+	return prop.Check(t, prop.PropFmt(triples, debugTriple(dbg),
+		func(p triple[T]) (bool, func() string) {
+			x, y, z := p.First, p.Second.First, p.Second.Second
+			lhs := s.Combine(s.Combine(x, y), z)
+			rhs := s.Combine(x, s.Combine(y, z))
+			return eq.Equal(lhs, rhs), func() string {
+				return "Combine(Combine(x, y), z) = " + dbg.DebugFmt(lhs) +
+					"\nCombine(x, Combine(y, z)) = " + dbg.DebugFmt(rhs)
+			}
+		},
+	).Named("associativity"))
+}
+
+// AssertMonoid checks that m satisfies the laws of Monoid using random values generated by arb:
+//
+//	Combine(Combine(x, y), z) == Combine(x, Combine(y, z))
+//	Combine(Empty(), x) == x
+//	Combine(x, Empty()) == x
+//
+// It reports shrunk counterexamples formatted by dbg and returns false if any of the laws doesn't hold.
+func AssertMonoid[T any](t testing.TB, m algebra.Monoid[T], eq cmp.Eq[T], arb arbitrary.Arbitrary[T], dbg debug.Debug[T]) bool {
+	t.Helper()
+	var s algebra.Semigroup[T] = m
+	ok := AssertSemigroup(t, s, eq, arb, dbg)
+	ok = prop.Check(t, prop.PropFmt(arb, debugSingle(dbg),
+		func(x T) (bool, func() string) {
+			y := m.Combine(m.Empty(), x)
+			return eq.Equal(y, x), func() string {
+				return "Combine(Empty(), x) = " + dbg.DebugFmt(y)
+			}
+		},
+	).Named("left identity")) && ok
+	ok = prop.Check(t, prop.PropFmt(arb, debugSingle(dbg),
+		func(x T) (bool, func() string) {
+			y := m.Combine(x, m.Empty())
+			return eq.Equal(y, x), func() string {
+				return "Combine(x, Empty()) = " + dbg.DebugFmt(y)
+			}
+		},
+	).Named("right identity")) && ok
+	return ok
+}
+
+// AssertGroup checks that g satisfies the laws of Group using random values generated by arb:
+//
+//	Combine(Combine(x, y), z) == Combine(x, Combine(y, z))
+//	Combine(Empty(), x) == x
+//	Combine(x, Empty()) == x
+//	Combine(x, Inverse(x)) == Empty()
+//	Combine(Inverse(x), x) == Empty()
+//
+// It reports shrunk counterexamples formatted by dbg and returns false if any of the laws doesn't hold.
+func AssertGroup[T any](t testing.TB, g algebra.Group[T], eq cmp.Eq[T], arb arbitrary.Arbitrary[T], dbg debug.Debug[T]) bool {
+	t.Helper()
+	var m algebra.Monoid[T] = g
+	ok := AssertMonoid(t, m, eq, arb, dbg)
+	ok = prop.Check(t, prop.PropFmt(arb, debugSingle(dbg),
+		func(x T) (bool, func() string) {
+			y := g.Combine(x, g.Inverse(x))
+			return eq.Equal(y, g.Empty()), func() string {
+				return "Combine(x, Inverse(x)) = " + dbg.DebugFmt(y)
+			}
+		},
+	).Named("right inverse")) && ok
+	ok = prop.Check(t, prop.PropFmt(arb, debugSingle(dbg),
+		func(x T) (bool, func() string) {
+			y := g.Combine(g.Inverse(x), x)
+			return eq.Equal(y, g.Empty()), func() string {
+				return "Combine(Inverse(x), x) = " + dbg.DebugFmt(y)
+			}
+		},
+	).Named("left inverse")) && ok
+	return ok
+}
+
+// AssertCommutative checks that Combine of s is commutative using random values generated by arb:
+//
+//	Combine(x, y) == Combine(y, x)
+//
+// It reports a shrunk counterexample formatted by dbg and returns false if the law doesn't hold.
+func AssertCommutative[T any](t testing.TB, s algebra.Semigroup[T], eq cmp.Eq[T], arb arbitrary.Arbitrary[T], dbg debug.Debug[T]) bool {
+	t.Helper()
+	return prop.Check(t, prop.PropFmt(pair.DerivePairArbitrary(arb, arb), debugPair(dbg),
+		func(p pair.Pair[T, T]) (bool, func() string) {
+			lhs := s.Combine(p.First, p.Second)
+			rhs := s.Combine(p.Second, p.First)
+			return eq.Equal(lhs, rhs), func() string {
+				return "Combine(x, y) = " + dbg.DebugFmt(lhs) +
+					"\nCombine(y, x) = " + dbg.DebugFmt(rhs)
+			}
+		},
+	).Named("commutativity"))
+}
+
+// AssertSemiring checks that s satisfies the laws of Semiring using random values generated by arb.
+// See the documentation of algebra.Semiring for the laws.
+// It reports shrunk counterexamples formatted by dbg and returns false if any of the laws doesn't hold.
+func AssertSemiring[T any](t testing.TB, s algebra.Semiring[T], eq cmp.Eq[T], arb arbitrary.Arbitrary[T], dbg debug.Debug[T]) bool {
+	t.Helper()
+	sum := algebra.DeriveSumMonoid(s)
+	ok := AssertMonoid[T](t, sum, eq, arb, dbg)
+	ok = AssertCommutative[T](t, sum, eq, arb, dbg) && ok
+	ok = AssertMonoid(t, algebra.DeriveProductMonoid(s), eq, arb, dbg) && ok
+	triples := pair.DerivePairArbitrary(arb, pair.DerivePairArbitrary(arb, arb))
+	ok = prop.Check(t, prop.PropFmt(triples, debugTriple(dbg),
+		func(p triple[T]) (bool, func() string) {
+			x, y, z := p.First, p.Second.First, p.Second.Second
+			lhs := s.Mul(x, s.Add(y, z))
+			rhs := s.Add(s.Mul(x, y), s.Mul(x, z))
+			return eq.Equal(lhs, rhs), func() string {
+				return "Mul(x, Add(y, z)) = " + dbg.DebugFmt(lhs) +
+					"\nAdd(Mul(x, y), Mul(x, z)) = " + dbg.DebugFmt(rhs)
+			}
+		},
+	).Named("left distributivity")) && ok
+	ok = prop.Check(t, prop.PropFmt(triples, debugTriple(dbg),
+		func(p triple[T]) (bool, func() string) {
+			x, y, z := p.First, p.Second.First, p.Second.Second
+			lhs := s.Mul(s.Add(x, y), z)
+			rhs := s.Add(s.Mul(x, z), s.Mul(y, z))
+			return eq.Equal(lhs, rhs), func() string {
+				return "Mul(Add(x, y), z) = " + dbg.DebugFmt(lhs) +
+					"\nAdd(Mul(x, z), Mul(y, z)) = " + dbg.DebugFmt(rhs)
+			}
+		},
+	).Named("right distributivity")) && ok
+	ok = prop.Check(t, prop.PropFmt(arb, debugSingle(dbg),
+		func(x T) (bool, func() string) {
+			lhs := s.Mul(s.Zero(), x)
+			rhs := s.Mul(x, s.Zero())
+			return eq.Equal(lhs, s.Zero()) && eq.Equal(rhs, s.Zero()), func() string {
+				return "Mul(Zero(), x) = " + dbg.DebugFmt(lhs) +
+					"\nMul(x, Zero()) = " + dbg.DebugFmt(rhs)
+			}
+		},
+	).Named("annihilation")) && ok
+	return ok
+}
+
+// AssertRing checks that r satisfies the laws of Ring using random values generated by arb.
+// In addition to the laws of Semiring, it checks that:
+//
+//	Add(x, Neg(x)) == Zero()
+//
+// It reports shrunk counterexamples formatted by dbg and returns false if any of the laws doesn't hold.
+func AssertRing[T any](t testing.TB, r algebra.Ring[T], eq cmp.Eq[T], arb arbitrary.Arbitrary[T], dbg debug.Debug[T]) bool {
+	t.Helper()
+	var s algebra.Semiring[T] = r
+	ok := AssertSemiring(t, s, eq, arb, dbg)
+	ok = prop.Check(t, prop.PropFmt(arb, debugSingle(dbg),
+		func(x T) (bool, func() string) {
+			y := r.Add(x, r.Neg(x))
+			return eq.Equal(y, r.Zero()), func() string {
+				return "Add(x, Neg(x)) = " + dbg.DebugFmt(y)
+			}
+		},
+	).Named("additive inverse")) && ok
+	return ok
+}
+
+// AssertLattice checks that l satisfies the laws of Lattice using random values generated by arb.
+// See the documentation of algebra.Lattice for the laws.
+// It reports shrunk counterexamples formatted by dbg and returns false if any of the laws doesn't hold.
+func AssertLattice[T any](t testing.TB, l algebra.Lattice[T], eq cmp.Eq[T], arb arbitrary.Arbitrary[T], dbg debug.Debug[T]) bool {
+	t.Helper()
+	join := &algebra.DefaultSemigroup[T]{CombineImpl: l.Join}
+	meet := &algebra.DefaultSemigroup[T]{CombineImpl: l.Meet}
+	ok := AssertSemigroup[T](t, join, eq, arb, dbg)
+	ok = AssertCommutative[T](t, join, eq, arb, dbg) && ok
+	ok = AssertSemigroup[T](t, meet, eq, arb, dbg) && ok
+	ok = AssertCommutative[T](t, meet, eq, arb, dbg) && ok
+	ok = prop.Check(t, prop.PropFmt(arb, debugSingle(dbg),
+		func(x T) (bool, func() string) {
+			j := l.Join(x, x)
+			m := l.Meet(x, x)
+			return eq.Equal(j, x) && eq.Equal(m, x), func() string {
+				return "Join(x, x) = " + dbg.DebugFmt(j) +
+					"\nMeet(x, x) = " + dbg.DebugFmt(m)
+			}
+		},
+	).Named("idempotency")) && ok
+	ok = prop.Check(t, prop.PropFmt(pair.DerivePairArbitrary(arb, arb), debugPair(dbg),
+		func(p pair.Pair[T, T]) (bool, func() string) {
+			x, y := p.First, p.Second
+			j := l.Join(x, l.Meet(x, y))
+			m := l.Meet(x, l.Join(x, y))
+			return eq.Equal(j, x) && eq.Equal(m, x), func() string {
+				return "Join(x, Meet(x, y)) = " + dbg.DebugFmt(j) +
+					"\nMeet(x, Join(x, y)) = " + dbg.DebugFmt(m)
+			}
+		},
+	).Named("absorption")) && ok
+	return ok
+}
+
+// AssertBoundedLattice checks that l satisfies the laws of BoundedLattice using random values generated by arb.
+// In addition to the laws of Lattice, it checks that:
+//
+//	Join(x, Bottom()) == x
+//	Meet(x, Top()) == x
+//
+// It reports shrunk counterexamples formatted by dbg and returns false if any of the laws doesn't hold.
+func AssertBoundedLattice[T any](t testing.TB, l algebra.BoundedLattice[T], eq cmp.Eq[T], arb arbitrary.Arbitrary[T], dbg debug.Debug[T]) bool {
+	t.Helper()
+	var ll algebra.Lattice[T] = l
+	ok := AssertLattice(t, ll, eq, arb, dbg)
+	ok = AssertMonoid(t, algebra.DeriveJoinMonoid(l), eq, arb, dbg) && ok
+	ok = AssertMonoid(t, algebra.DeriveMeetMonoid(l), eq, arb, dbg) && ok
+	return ok
+}
+
+func debugSingle[T any](dbg debug.Debug[T]) debug.Debug[T] {
+	return &debug.DefaultDebug[T]{
+		DebugFmtImpl: func(x T) string {
+			return "x = " + dbg.DebugFmt(x)
+		},
+	}
+}
+
+func debugPair[T any](dbg debug.Debug[T]) debug.Debug[pair.Pair[T, T]] {
+	return &debug.DefaultDebug[pair.Pair[T, T]]{
+		DebugFmtImpl: func(p pair.Pair[T, T]) string {
+			return "x = " + dbg.DebugFmt(p.First) + ", y = " + dbg.DebugFmt(p.Second)
+		},
+	}
+}
+
+func debugTriple[T any](dbg debug.Debug[T]) debug.Debug[triple[T]] {
+	return &debug.DefaultDebug[triple[T]]{
+		DebugFmtImpl: func(p triple[T]) string {
+			return "x = " + dbg.DebugFmt(p.First) +
+				", y = " + dbg.DebugFmt(p.Second.First) +
+				", z = " + dbg.DebugFmt(p.Second.Second)
+		},
+	}
+}
@@ -0,0 +1,217 @@
+package algebratest_test
+
+import (
+	"fmt"
+	"github.com/genkami/dogs/classes/algebra"
+	"github.com/genkami/dogs/classes/algebra/algebratest"
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/types/option"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// fakeT records errors instead of failing the test.
+type fakeT struct {
+	testing.TB
+	errors []string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertSemigroup(t *testing.T) {
+	eq := cmp.DeriveEq[int]()
+	arb := arbitrary.Int[int]()
+	dbg := debug.DeriveDebug[int]()
+
+	t.Run("ok", func(t *testing.T) {
+		algebratest.AssertSemigroup(t, algebra.DeriveAdditiveSemigroup[int](), eq, arb, dbg)
+		algebratest.AssertSemigroup(t, algebra.DeriveMultiplicativeSemigroup[int](), eq, arb, dbg)
+		algebratest.AssertSemigroup(t,
+			algebra.DeriveAdditiveSemigroup[string](),
+			cmp.DeriveEq[string](), arbitrary.String(), debug.DeriveDebug[string](),
+		)
+	})
+
+	t.Run("not associative", func(t *testing.T) {
+		sub := &algebra.DefaultSemigroup[int]{
+			CombineImpl: func(x, y int) int { return x - y },
+		}
+		fake := &fakeT{TB: t}
+		ok := algebratest.AssertSemigroup[int](fake, sub, eq, arb, dbg)
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 1)
+		assert.Contains(t, fake.errors[0], "associativity")
+		assert.Contains(t, fake.errors[0], "x = 0, y = 0, z = 1")
+		assert.Contains(t, fake.errors[0], "seed")
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		div := &algebra.DefaultSemigroup[int]{
+			CombineImpl: func(x, y int) int { return x / y },
+		}
+		fake := &fakeT{TB: t}
+		ok := algebratest.AssertSemigroup[int](fake, div, eq, arb, dbg)
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 1)
+		assert.Contains(t, fake.errors[0], "associativity")
+		assert.Contains(t, fake.errors[0], "divide by zero")
+	})
+}
+
+func TestAssertMonoid(t *testing.T) {
+	eq := cmp.DeriveEq[int]()
+	arb := arbitrary.Int[int]()
+	dbg := debug.DeriveDebug[int]()
+
+	t.Run("ok", func(t *testing.T) {
+		algebratest.AssertMonoid(t, algebra.DeriveAdditiveMonoid[int](), eq, arb, dbg)
+		algebratest.AssertMonoid(t, algebra.DeriveMultiplicativeMonoid[int](), eq, arb, dbg)
+		algebratest.AssertMonoid(t,
+			option.DeriveMonoid(algebra.DeriveAdditiveSemigroup[string]()),
+			&cmp.DefaultEq[option.Option[string]]{EqualImpl: option.Equal[string]},
+			option.DeriveArbitrary(arbitrary.String()),
+			option.DeriveDebug(debug.DeriveDebug[string]()),
+		)
+	})
+
+	t.Run("wrong identity", func(t *testing.T) {
+		m := &algebra.DefaultMonoid[int]{
+			Semigroup: algebra.DeriveAdditiveSemigroup[int](),
+			EmptyImpl: func() int { return 1 },
+		}
+		fake := &fakeT{TB: t}
+		ok := algebratest.AssertMonoid[int](fake, m, eq, arb, dbg)
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 2)
+		assert.Contains(t, fake.errors[0], "left identity")
+		assert.Contains(t, fake.errors[0], "x = 0")
+		assert.Contains(t, fake.errors[1], "right identity")
+		assert.Contains(t, fake.errors[1], "x = 0")
+	})
+}
+
+func TestAssertGroup(t *testing.T) {
+	eq := cmp.DeriveEq[int]()
+	arb := arbitrary.Int[int]()
+	dbg := debug.DeriveDebug[int]()
+
+	t.Run("ok", func(t *testing.T) {
+		algebratest.AssertGroup(t, algebra.DeriveAdditiveGroup[int](), eq, arb, dbg)
+	})
+
+	t.Run("wrong inverse", func(t *testing.T) {
+		g := &algebra.DefaultGroup[int]{
+			Monoid:      algebra.DeriveAdditiveMonoid[int](),
+			InverseImpl: func(x int) int { return x },
+		}
+		fake := &fakeT{TB: t}
+		ok := algebratest.AssertGroup[int](fake, g, eq, arb, dbg)
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 2)
+		assert.Contains(t, fake.errors[0], "right inverse")
+		assert.Contains(t, fake.errors[0], "x = 1")
+		assert.Contains(t, fake.errors[1], "left inverse")
+	})
+}
+
+func TestAssertCommutative(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		algebratest.AssertCommutative(t,
+			algebra.DeriveAdditiveSemigroup[int](),
+			cmp.DeriveEq[int](), arbitrary.Int[int](), debug.DeriveDebug[int](),
+		)
+	})
+
+	t.Run("not commutative", func(t *testing.T) {
+		fake := &fakeT{TB: t}
+		ok := algebratest.AssertCommutative(fake,
+			algebra.DeriveAdditiveSemigroup[string](),
+			cmp.DeriveEq[string](), arbitrary.String(), debug.DeriveDebug[string](),
+		)
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 1)
+		assert.Contains(t, fake.errors[0], "commutativity")
+	})
+}
+
+func TestAssertSemiring(t *testing.T) {
+	eq := cmp.DeriveEq[int]()
+	arb := arbitrary.Int[int]()
+	dbg := debug.DeriveDebug[int]()
+
+	t.Run("ok", func(t *testing.T) {
+		algebratest.AssertSemiring(t, algebra.DeriveNumericSemiring[int](), eq, arb, dbg)
+	})
+
+	t.Run("not distributive", func(t *testing.T) {
+		// Addition doesn't distribute over itself.
+		s := &algebra.DefaultSemiring[int]{
+			Additive: algebra.DefaultCommutativeMonoid[int]{
+				Monoid: algebra.DeriveAdditiveMonoid[int](),
+			},
+			Multiplicative: algebra.DeriveAdditiveMonoid[int](),
+		}
+		fake := &fakeT{TB: t}
+		ok := algebratest.AssertSemiring[int](fake, s, eq, arb, dbg)
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 3)
+		assert.Contains(t, fake.errors[0], "left distributivity")
+		assert.Contains(t, fake.errors[1], "right distributivity")
+		assert.Contains(t, fake.errors[2], "annihilation")
+	})
+}
+
+func TestAssertRing(t *testing.T) {
+	algebratest.AssertRing(t,
+		algebra.DeriveNumericRing[int](),
+		cmp.DeriveEq[int](), arbitrary.Int[int](), debug.DeriveDebug[int](),
+	)
+}
+
+func TestAssertLattice(t *testing.T) {
+	eq := cmp.DeriveEq[int]()
+	arb := arbitrary.Int[int]()
+	dbg := debug.DeriveDebug[int]()
+
+	t.Run("ok", func(t *testing.T) {
+		algebratest.AssertLattice(t, algebra.DeriveOrdLattice(cmp.DeriveOrd[int]()), eq, arb, dbg)
+	})
+
+	t.Run("not absorptive", func(t *testing.T) {
+		l := &algebra.DefaultLattice[int]{
+			JoinImpl: algebra.DeriveMaxSemigroup(cmp.DeriveOrd[int]()).Combine,
+			MeetImpl: algebra.DeriveMaxSemigroup(cmp.DeriveOrd[int]()).Combine,
+		}
+		fake := &fakeT{TB: t}
+		ok := algebratest.AssertLattice[int](fake, l, eq, arb, dbg)
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 1)
+		assert.Contains(t, fake.errors[0], "absorption")
+	})
+}
+
+func TestAssertBoundedLattice(t *testing.T) {
+	eq := cmp.DeriveEq[bool]()
+	arb := arbitrary.Bool()
+	dbg := debug.DeriveDebug[bool]()
+
+	t.Run("ok", func(t *testing.T) {
+		algebratest.AssertBoundedLattice(t, algebra.DeriveBoolLattice(), eq, arb, dbg)
+	})
+
+	t.Run("wrong bounds", func(t *testing.T) {
+		l := algebra.DeriveBoundedOrdLattice(cmp.DeriveOrd[int](), 1, 0)
+		fake := &fakeT{TB: t}
+		ok := algebratest.AssertBoundedLattice(fake, l,
+			cmp.DeriveEq[int](), arbitrary.Int[int](), debug.DeriveDebug[int](),
+		)
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 4)
+	})
+}
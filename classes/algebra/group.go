@@ -0,0 +1,92 @@
+package algebra
+
+// Negatable is a type that can use unary `-` operator.
+// Every Multiplicative type can use it.
+type Negatable interface {
+	Multiplicative
+}
+
+// Group is a Monoid whose elements have inverses.
+type Group[T any] interface {
+	Monoid[T]
+
+	// Inverse(x) returns y such that Combine(x, y) == Combine(y, x) == Empty().
+	Inverse(T) T
+}
+
+// DefaultGroup is a default implementation of Group.
+type DefaultGroup[T any] struct {
+	Monoid[T]
+	InverseImpl func(T) T
+}
+
+func (g *DefaultGroup[T]) Inverse(x T) T {
+	return g.InverseImpl(x)
+}
+
+// DeriveAdditiveGroup derives Group using `+`, zero value and unary `-`.
+// Note that floating-point numbers satisfy the laws only approximately due to rounding errors.
+func DeriveAdditiveGroup[T Negatable]() Group[T] {
+	return additiveGroup[T]{}
+}
+
+type additiveGroup[T Negatable] struct{}
+
+func (additiveGroup[T]) Combine(x, y T) T {
+	return x + y
+}
+
+func (additiveGroup[T]) Empty() (zero T) {
+	return
+}
+
+func (additiveGroup[T]) Inverse(x T) T {
+	return -x
+}
+
+func (additiveGroup[T]) Commutative() {}
+
+// CommutativeSemigroup is a Semigroup whose Combine is commutative, that is, Combine(x, y) == Combine(y, x).
+// Commutative is a marker method that does nothing.
+type CommutativeSemigroup[T any] interface {
+	Semigroup[T]
+	Commutative()
+}
+
+// CommutativeMonoid is a Monoid whose Combine is commutative, that is, Combine(x, y) == Combine(y, x).
+// Commutative is a marker method that does nothing.
+type CommutativeMonoid[T any] interface {
+	Monoid[T]
+	Commutative()
+}
+
+// CommutativeGroup is a Group whose Combine is commutative, that is, Combine(x, y) == Combine(y, x).
+// Commutative is a marker method that does nothing.
+type CommutativeGroup[T any] interface {
+	Group[T]
+	Commutative()
+}
+
+// DefaultCommutativeSemigroup marks a Semigroup as commutative.
+// It is up to the caller to make sure that the Semigroup is actually commutative.
+type DefaultCommutativeSemigroup[T any] struct {
+	Semigroup[T]
+}
+
+func (DefaultCommutativeSemigroup[T]) Commutative() {}
+
+// DefaultCommutativeMonoid marks a Monoid as commutative.
+// It is up to the caller to make sure that the Monoid is actually commutative.
+type DefaultCommutativeMonoid[T any] struct {
+	Monoid[T]
+}
+
+func (DefaultCommutativeMonoid[T]) Commutative() {}
+
+// DefaultCommutativeGroup marks a Group as commutative.
+// It is up to the caller to make sure that the Group is actually commutative.
+type DefaultCommutativeGroup[T any] struct {
+	Group[T]
+}
+
+func (DefaultCommutativeGroup[T]) Commutative() {}
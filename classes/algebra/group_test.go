@@ -0,0 +1,37 @@
+package algebra_test
+
+import (
+	"github.com/genkami/dogs/classes/algebra"
+	"github.com/genkami/dogs/classes/algebra/algebratest"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDeriveAdditiveGroup(t *testing.T) {
+	g := algebra.DeriveAdditiveGroup[int]()
+	assert.Equal(t, g.Combine(1, 2), 3)
+	assert.Equal(t, g.Empty(), 0)
+	assert.Equal(t, g.Inverse(3), -3)
+	algebratest.AssertGroup(t, g, eqInt, arbInt, dbgInt)
+
+	var s algebra.Semigroup[int] = g
+	_, ok := s.(algebra.CommutativeSemigroup[int])
+	assert.True(t, ok)
+}
+
+func TestDefaultGroup(t *testing.T) {
+	g := &algebra.DefaultGroup[int]{
+		Monoid:      algebra.DeriveAdditiveMonoid[int](),
+		InverseImpl: func(x int) int { return -x },
+	}
+	assert.Equal(t, g.Inverse(3), -3)
+	algebratest.AssertGroup[int](t, g, eqInt, arbInt, dbgInt)
+}
+
+func TestDefaultCommutativeMonoid(t *testing.T) {
+	var m algebra.CommutativeMonoid[int] = algebra.DefaultCommutativeMonoid[int]{
+		Monoid: algebra.DeriveAdditiveMonoid[int](),
+	}
+	assert.Equal(t, m.Combine(1, 2), 3)
+	algebratest.AssertCommutative[int](t, m, eqInt, arbInt, dbgInt)
+}
@@ -0,0 +1,121 @@
+package algebra
+
+import "github.com/genkami/dogs/classes/cmp"
+
+// DeriveFirstSemigroup derives Semigroup whose Combine returns the first argument.
+func DeriveFirstSemigroup[T any]() Semigroup[T] {
+	return &DefaultSemigroup[T]{
+		CombineImpl: func(x, _ T) T {
+			return x
+		},
+	}
+}
+
+// DeriveLastSemigroup derives Semigroup whose Combine returns the second argument.
+func DeriveLastSemigroup[T any]() Semigroup[T] {
+	return &DefaultSemigroup[T]{
+		CombineImpl: func(_, y T) T {
+			return y
+		},
+	}
+}
+
+// DeriveMinSemigroup derives Semigroup whose Combine returns the smaller argument in terms of ord.
+// It returns the first argument if both are equal.
+func DeriveMinSemigroup[T any](ord cmp.Ord[T]) Semigroup[T] {
+	return &DefaultSemigroup[T]{
+		CombineImpl: func(x, y T) T {
+			if ord.Le(x, y) {
+				return x
+			}
+			return y
+		},
+	}
+}
+
+// DeriveMaxSemigroup derives Semigroup whose Combine returns the larger argument in terms of ord.
+// It returns the first argument if both are equal.
+func DeriveMaxSemigroup[T any](ord cmp.Ord[T]) Semigroup[T] {
+	return &DefaultSemigroup[T]{
+		CombineImpl: func(x, y T) T {
+			if ord.Ge(x, y) {
+				return x
+			}
+			return y
+		},
+	}
+}
+
+// DeriveAnyMonoid derives Monoid using `||` and false.
+func DeriveAnyMonoid() Monoid[bool] {
+	return &DefaultMonoid[bool]{
+		Semigroup: &DefaultSemigroup[bool]{
+			CombineImpl: func(x, y bool) bool {
+				return x || y
+			},
+		},
+		EmptyImpl: func() bool {
+			return false
+		},
+	}
+}
+
+// DeriveAllMonoid derives Monoid using `&&` and true.
+func DeriveAllMonoid() Monoid[bool] {
+	return &DefaultMonoid[bool]{
+		Semigroup: &DefaultSemigroup[bool]{
+			CombineImpl: func(x, y bool) bool {
+				return x && y
+			},
+		},
+		EmptyImpl: func() bool {
+			return true
+		},
+	}
+}
+
+// DeriveEndoMonoid derives Monoid of functions from T to T.
+// Combine(f, g) returns a function that applies f and then g, and Empty returns the identity function.
+func DeriveEndoMonoid[T any]() Monoid[func(T) T] {
+	return &DefaultMonoid[func(T) T]{
+		Semigroup: &DefaultSemigroup[func(T) T]{
+			CombineImpl: func(f, g func(T) T) func(T) T {
+				return func(x T) T {
+					return g(f(x))
+				}
+			},
+		},
+		EmptyImpl: func() func(T) T {
+			return func(x T) T {
+				return x
+			}
+		},
+	}
+}
+
+// DeriveDualSemigroup derives Semigroup that is the same as s except that the arguments of Combine are flipped.
+func DeriveDualSemigroup[T any](s Semigroup[T]) Semigroup[T] {
+	return &DefaultSemigroup[T]{
+		CombineImpl: func(x, y T) T {
+			return s.Combine(y, x)
+		},
+	}
+}
+
+// DeriveDualMonoid derives Monoid that is the same as m except that the arguments of Combine are flipped.
+func DeriveDualMonoid[T any](m Monoid[T]) Monoid[T] {
+	return &DefaultMonoid[T]{
+		Semigroup: DeriveDualSemigroup[T](m),
+		EmptyImpl: m.Empty,
+	}
+}
+
+// DeriveConstSemigroup derives Semigroup whose Combine always returns c.
+// Note that there is no Monoid counterpart since c cannot be the identity element unless T has only one value.
+func DeriveConstSemigroup[T any](c T) Semigroup[T] {
+	return &DefaultSemigroup[T]{
+		CombineImpl: func(_, _ T) T {
+			return c
+		},
+	}
+}
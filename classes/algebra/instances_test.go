@@ -0,0 +1,115 @@
+package algebra_test
+
+import (
+	"github.com/genkami/dogs/classes/algebra"
+	"github.com/genkami/dogs/classes/algebra/algebratest"
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+var (
+	eqInt  = cmp.DeriveEq[int]()
+	arbInt = arbitrary.Int[int]()
+	dbgInt = debug.DeriveDebug[int]()
+)
+
+func TestDeriveFirstSemigroup(t *testing.T) {
+	s := algebra.DeriveFirstSemigroup[int]()
+	assert.Equal(t, s.Combine(1, 2), 1)
+	algebratest.AssertSemigroup(t, s, eqInt, arbInt, dbgInt)
+}
+
+func TestDeriveLastSemigroup(t *testing.T) {
+	s := algebra.DeriveLastSemigroup[int]()
+	assert.Equal(t, s.Combine(1, 2), 2)
+	algebratest.AssertSemigroup(t, s, eqInt, arbInt, dbgInt)
+}
+
+func TestDeriveMinSemigroup(t *testing.T) {
+	s := algebra.DeriveMinSemigroup(cmp.DeriveOrd[int]())
+	assert.Equal(t, s.Combine(1, 2), 1)
+	assert.Equal(t, s.Combine(2, 1), 1)
+	algebratest.AssertSemigroup(t, s, eqInt, arbInt, dbgInt)
+}
+
+func TestDeriveMaxSemigroup(t *testing.T) {
+	s := algebra.DeriveMaxSemigroup(cmp.DeriveOrd[int]())
+	assert.Equal(t, s.Combine(1, 2), 2)
+	assert.Equal(t, s.Combine(2, 1), 2)
+	algebratest.AssertSemigroup(t, s, eqInt, arbInt, dbgInt)
+}
+
+func TestDeriveAnyMonoid(t *testing.T) {
+	m := algebra.DeriveAnyMonoid()
+	assert.False(t, m.Combine(false, false))
+	assert.True(t, m.Combine(false, true))
+	assert.True(t, m.Combine(true, false))
+	assert.True(t, m.Combine(true, true))
+	algebratest.AssertMonoid(t, m, cmp.DeriveEq[bool](), arbitrary.Bool(), debug.DeriveDebug[bool]())
+}
+
+func TestDeriveAllMonoid(t *testing.T) {
+	m := algebra.DeriveAllMonoid()
+	assert.False(t, m.Combine(false, false))
+	assert.False(t, m.Combine(false, true))
+	assert.False(t, m.Combine(true, false))
+	assert.True(t, m.Combine(true, true))
+	algebratest.AssertMonoid(t, m, cmp.DeriveEq[bool](), arbitrary.Bool(), debug.DeriveDebug[bool]())
+}
+
+func TestDeriveEndoMonoid(t *testing.T) {
+	m := algebra.DeriveEndoMonoid[int]()
+	double := func(x int) int { return x * 2 }
+	inc := func(x int) int { return x + 1 }
+	assert.Equal(t, m.Combine(double, inc)(3), 7)
+	assert.Equal(t, m.Combine(inc, double)(3), 8)
+	assert.Equal(t, m.Empty()(3), 3)
+
+	// Functions are compared by their results for some inputs.
+	type F = func(int) int
+	eq := &cmp.DefaultEq[F]{
+		EqualImpl: func(f, g F) bool {
+			for x := -10; x <= 10; x++ {
+				if f(x) != g(x) {
+					return false
+				}
+			}
+			return true
+		},
+	}
+	arb := &arbitrary.DefaultArbitrary[F]{
+		ChooseImpl: func(r *rand.Rand) F {
+			a, b := r.Intn(10)-5, r.Intn(10)-5
+			return func(x int) int { return a*x + b }
+		},
+	}
+	dbg := &debug.DefaultDebug[F]{
+		DebugFmtImpl: func(f F) string {
+			return "x -> " + dbgInt.DebugFmt(f(0)) + " + (" + dbgInt.DebugFmt(f(1)-f(0)) + ") * x"
+		},
+	}
+	algebratest.AssertMonoid(t, m, eq, arb, dbg)
+}
+
+func TestDeriveDualSemigroup(t *testing.T) {
+	s := algebra.DeriveDualSemigroup(algebra.DeriveAdditiveSemigroup[string]())
+	assert.Equal(t, s.Combine("a", "b"), "ba")
+	algebratest.AssertSemigroup(t, s, cmp.DeriveEq[string](), arbitrary.String(), debug.DeriveDebug[string]())
+}
+
+func TestDeriveDualMonoid(t *testing.T) {
+	m := algebra.DeriveDualMonoid(algebra.DeriveAdditiveMonoid[string]())
+	assert.Equal(t, m.Combine("a", "b"), "ba")
+	assert.Equal(t, m.Empty(), "")
+	algebratest.AssertMonoid(t, m, cmp.DeriveEq[string](), arbitrary.String(), debug.DeriveDebug[string]())
+}
+
+func TestDeriveConstSemigroup(t *testing.T) {
+	s := algebra.DeriveConstSemigroup(42)
+	assert.Equal(t, s.Combine(1, 2), 42)
+	algebratest.AssertSemigroup(t, s, eqInt, arbInt, dbgInt)
+}
@@ -0,0 +1,96 @@
+package algebra
+
+import "github.com/genkami/dogs/classes/cmp"
+
+// Lattice is a set of type `T` with two binary operations `Join` (least upper bound) and `Meet` (greatest lower bound)
+// such that both of them are associative, commutative and idempotent, and they satisfy the absorption laws:
+//
+//	Join(x, Meet(x, y)) == x
+//	Meet(x, Join(x, y)) == x
+type Lattice[T any] interface {
+	Join(T, T) T
+	Meet(T, T) T
+}
+
+// DefaultLattice is a default implementation of Lattice.
+type DefaultLattice[T any] struct {
+	JoinImpl func(T, T) T
+	MeetImpl func(T, T) T
+}
+
+func (l *DefaultLattice[T]) Join(x, y T) T {
+	return l.JoinImpl(x, y)
+}
+
+func (l *DefaultLattice[T]) Meet(x, y T) T {
+	return l.MeetImpl(x, y)
+}
+
+// BoundedLattice is a Lattice with the greatest element `Top()` and the least element `Bottom()`, that is,
+// Top() is the identity element of Meet and Bottom() is the identity element of Join.
+type BoundedLattice[T any] interface {
+	Lattice[T]
+	Top() T
+	Bottom() T
+}
+
+// DefaultBoundedLattice is a default implementation of BoundedLattice.
+type DefaultBoundedLattice[T any] struct {
+	Lattice[T]
+	TopImpl    func() T
+	BottomImpl func() T
+}
+
+func (l *DefaultBoundedLattice[T]) Top() T {
+	return l.TopImpl()
+}
+
+func (l *DefaultBoundedLattice[T]) Bottom() T {
+	return l.BottomImpl()
+}
+
+// DeriveOrdLattice derives Lattice from Ord whose Join returns the larger argument and Meet returns the smaller one.
+// For example, DeriveOrdLattice(cmp.DeriveOrd[int]()) is a Lattice of integers.
+func DeriveOrdLattice[T any](ord cmp.Ord[T]) Lattice[T] {
+	return &DefaultLattice[T]{
+		JoinImpl: DeriveMaxSemigroup(ord).Combine,
+		MeetImpl: DeriveMinSemigroup(ord).Combine,
+	}
+}
+
+// DeriveBoundedOrdLattice derives BoundedLattice from Ord and its least and greatest elements.
+func DeriveBoundedOrdLattice[T any](ord cmp.Ord[T], bottom, top T) BoundedLattice[T] {
+	return &DefaultBoundedLattice[T]{
+		Lattice:    DeriveOrdLattice(ord),
+		TopImpl:    func() T { return top },
+		BottomImpl: func() T { return bottom },
+	}
+}
+
+// DeriveBoolLattice derives BoundedLattice using `||`, `&&`, true and false.
+func DeriveBoolLattice() BoundedLattice[bool] {
+	return &DefaultBoundedLattice[bool]{
+		Lattice: &DefaultLattice[bool]{
+			JoinImpl: DeriveAnyMonoid().Combine,
+			MeetImpl: DeriveAllMonoid().Combine,
+		},
+		TopImpl:    func() bool { return true },
+		BottomImpl: func() bool { return false },
+	}
+}
+
+// DeriveJoinMonoid derives Monoid whose Combine is l.Join and Empty is l.Bottom.
+func DeriveJoinMonoid[T any](l BoundedLattice[T]) Monoid[T] {
+	return &DefaultMonoid[T]{
+		Semigroup: &DefaultSemigroup[T]{CombineImpl: l.Join},
+		EmptyImpl: l.Bottom,
+	}
+}
+
+// DeriveMeetMonoid derives Monoid whose Combine is l.Meet and Empty is l.Top.
+func DeriveMeetMonoid[T any](l BoundedLattice[T]) Monoid[T] {
+	return &DefaultMonoid[T]{
+		Semigroup: &DefaultSemigroup[T]{CombineImpl: l.Meet},
+		EmptyImpl: l.Top,
+	}
+}
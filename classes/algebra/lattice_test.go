@@ -0,0 +1,45 @@
+package algebra_test
+
+import (
+	"github.com/genkami/dogs/classes/algebra"
+	"github.com/genkami/dogs/classes/algebra/algebratest"
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestDeriveOrdLattice(t *testing.T) {
+	l := algebra.DeriveOrdLattice(cmp.DeriveOrd[int]())
+	assert.Equal(t, l.Join(1, 2), 2)
+	assert.Equal(t, l.Meet(1, 2), 1)
+	algebratest.AssertLattice(t, l, eqInt, arbInt, dbgInt)
+}
+
+func TestDeriveBoundedOrdLattice(t *testing.T) {
+	l := algebra.DeriveBoundedOrdLattice(cmp.DeriveOrd[int8](), math.MinInt8, math.MaxInt8)
+	assert.Equal(t, l.Top(), int8(math.MaxInt8))
+	assert.Equal(t, l.Bottom(), int8(math.MinInt8))
+	algebratest.AssertBoundedLattice(t, l, cmp.DeriveEq[int8](), arbitrary.Int[int8](), debug.DeriveDebug[int8]())
+}
+
+func TestDeriveBoolLattice(t *testing.T) {
+	l := algebra.DeriveBoolLattice()
+	assert.True(t, l.Join(false, true))
+	assert.False(t, l.Meet(false, true))
+	algebratest.AssertBoundedLattice(t, l, cmp.DeriveEq[bool](), arbitrary.Bool(), debug.DeriveDebug[bool]())
+}
+
+func TestDeriveJoinMonoid(t *testing.T) {
+	m := algebra.DeriveJoinMonoid(algebra.DeriveBoolLattice())
+	assert.True(t, m.Combine(false, true))
+	assert.False(t, m.Empty())
+}
+
+func TestDeriveMeetMonoid(t *testing.T) {
+	m := algebra.DeriveMeetMonoid(algebra.DeriveBoolLattice())
+	assert.False(t, m.Combine(false, true))
+	assert.True(t, m.Empty())
+}
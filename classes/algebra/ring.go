@@ -0,0 +1,127 @@
+package algebra
+
+// Semiring is a set of type `T` with two binary operations `Add` and `Mul` such that:
+//
+//	Add is associative and commutative, and Zero() is its identity element,
+//	Mul is associative, and One() is its identity element,
+//	Mul distributes over Add, that is, Mul(x, Add(y, z)) == Add(Mul(x, y), Mul(x, z)) and Mul(Add(x, y), z) == Add(Mul(x, z), Mul(y, z)),
+//	Zero() annihilates, that is, Mul(Zero(), x) == Mul(x, Zero()) == Zero().
+type Semiring[T any] interface {
+	Add(T, T) T
+	Zero() T
+	Mul(T, T) T
+	One() T
+}
+
+// DefaultSemiring is a Semiring made of two Monoids.
+type DefaultSemiring[T any] struct {
+	// Additive is used to implement Add and Zero.
+	Additive CommutativeMonoid[T]
+
+	// Multiplicative is used to implement Mul and One.
+	Multiplicative Monoid[T]
+}
+
+func (s *DefaultSemiring[T]) Add(x, y T) T {
+	return s.Additive.Combine(x, y)
+}
+
+func (s *DefaultSemiring[T]) Zero() T {
+	return s.Additive.Empty()
+}
+
+func (s *DefaultSemiring[T]) Mul(x, y T) T {
+	return s.Multiplicative.Combine(x, y)
+}
+
+func (s *DefaultSemiring[T]) One() T {
+	return s.Multiplicative.Empty()
+}
+
+// Ring is a Semiring whose elements have additive inverses.
+type Ring[T any] interface {
+	Semiring[T]
+
+	// Neg(x) returns y such that Add(x, y) == Zero().
+	Neg(T) T
+}
+
+// DefaultRing is a Ring made of a Group and a Monoid.
+type DefaultRing[T any] struct {
+	// Additive is used to implement Add, Zero and Neg.
+	Additive CommutativeGroup[T]
+
+	// Multiplicative is used to implement Mul and One.
+	Multiplicative Monoid[T]
+}
+
+func (r *DefaultRing[T]) Add(x, y T) T {
+	return r.Additive.Combine(x, y)
+}
+
+func (r *DefaultRing[T]) Zero() T {
+	return r.Additive.Empty()
+}
+
+func (r *DefaultRing[T]) Neg(x T) T {
+	return r.Additive.Inverse(x)
+}
+
+func (r *DefaultRing[T]) Mul(x, y T) T {
+	return r.Multiplicative.Combine(x, y)
+}
+
+func (r *DefaultRing[T]) One() T {
+	return r.Multiplicative.Empty()
+}
+
+// DeriveNumericSemiring derives Semiring using `+`, `*`, zero value and `1`.
+// Note that floating-point numbers satisfy the laws only approximately due to rounding errors.
+func DeriveNumericSemiring[T Negatable]() Semiring[T] {
+	return DeriveNumericRing[T]()
+}
+
+// DeriveNumericRing derives Ring using `+`, `*`, unary `-`, zero value and `1`.
+// Note that floating-point numbers satisfy the laws only approximately due to rounding errors.
+func DeriveNumericRing[T Negatable]() Ring[T] {
+	return &DefaultRing[T]{
+		Additive:       additiveGroup[T]{},
+		Multiplicative: DeriveMultiplicativeMonoid[T](),
+	}
+}
+
+// DeriveBoolSemiring derives Semiring using `||`, `&&`, false and true.
+func DeriveBoolSemiring() Semiring[bool] {
+	return &DefaultSemiring[bool]{
+		Additive:       DefaultCommutativeMonoid[bool]{DeriveAnyMonoid()},
+		Multiplicative: DeriveAllMonoid(),
+	}
+}
+
+// DeriveSumMonoid derives CommutativeMonoid whose Combine is s.Add and Empty is s.Zero.
+func DeriveSumMonoid[T any](s Semiring[T]) CommutativeMonoid[T] {
+	return DefaultCommutativeMonoid[T]{
+		Monoid: &DefaultMonoid[T]{
+			Semigroup: &DefaultSemigroup[T]{CombineImpl: s.Add},
+			EmptyImpl: s.Zero,
+		},
+	}
+}
+
+// DeriveProductMonoid derives Monoid whose Combine is s.Mul and Empty is s.One.
+func DeriveProductMonoid[T any](s Semiring[T]) Monoid[T] {
+	return &DefaultMonoid[T]{
+		Semigroup: &DefaultSemigroup[T]{CombineImpl: s.Mul},
+		EmptyImpl: s.One,
+	}
+}
+
+// DeriveSumGroup derives CommutativeGroup whose Combine is r.Add, Empty is r.Zero and Inverse is r.Neg.
+func DeriveSumGroup[T any](r Ring[T]) CommutativeGroup[T] {
+	return DefaultCommutativeGroup[T]{
+		Group: &DefaultGroup[T]{
+			Monoid:      DeriveSumMonoid[T](r),
+			InverseImpl: r.Neg,
+		},
+	}
+}
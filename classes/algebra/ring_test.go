@@ -0,0 +1,53 @@
+package algebra_test
+
+import (
+	"github.com/genkami/dogs/classes/algebra"
+	"github.com/genkami/dogs/classes/algebra/algebratest"
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/types/iterator"
+	"github.com/genkami/dogs/types/slice"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDeriveNumericSemiring(t *testing.T) {
+	s := algebra.DeriveNumericSemiring[int]()
+	assert.Equal(t, s.Add(2, 3), 5)
+	assert.Equal(t, s.Mul(2, 3), 6)
+	assert.Equal(t, s.Zero(), 0)
+	assert.Equal(t, s.One(), 1)
+	algebratest.AssertSemiring(t, s, eqInt, arbInt, dbgInt)
+}
+
+func TestDeriveNumericRing(t *testing.T) {
+	r := algebra.DeriveNumericRing[int]()
+	assert.Equal(t, r.Neg(2), -2)
+	algebratest.AssertRing(t, r, eqInt, arbInt, dbgInt)
+}
+
+func TestDeriveBoolSemiring(t *testing.T) {
+	s := algebra.DeriveBoolSemiring()
+	assert.True(t, s.Add(false, true))
+	assert.False(t, s.Mul(false, true))
+	assert.False(t, s.Zero())
+	assert.True(t, s.One())
+	algebratest.AssertSemiring(t, s, cmp.DeriveEq[bool](), arbitrary.Bool(), debug.DeriveDebug[bool]())
+}
+
+func TestDeriveSumMonoid(t *testing.T) {
+	m := algebra.DeriveSumMonoid(algebra.DeriveNumericSemiring[int]())
+	assert.Equal(t, iterator.Sum[int](m)(slice.Slice[int]{1, 2, 3, 4}.Iter()), 10)
+}
+
+func TestDeriveProductMonoid(t *testing.T) {
+	m := algebra.DeriveProductMonoid(algebra.DeriveNumericSemiring[int]())
+	assert.Equal(t, iterator.Sum(m)(slice.Slice[int]{1, 2, 3, 4}.Iter()), 24)
+}
+
+func TestDeriveSumGroup(t *testing.T) {
+	g := algebra.DeriveSumGroup(algebra.DeriveNumericRing[int]())
+	assert.Equal(t, g.Inverse(3), -3)
+	algebratest.AssertGroup[int](t, g, eqInt, arbInt, dbgInt)
+}
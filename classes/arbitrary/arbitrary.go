@@ -1,4 +1,218 @@
 package arbitrary
 
-// TODO: type Arbitrary[T any] interface { Choose() T }
-// TODO: Int, String, etc.
+import (
+	"golang.org/x/exp/constraints"
+	"math"
+	"math/rand"
+	"unicode/utf8"
+)
+
+// Arbitrary generates random values of type T.
+type Arbitrary[T any] interface {
+	// Choose returns a random value.
+	// It must return the same value for the same state of the given *rand.Rand so that results are reproducible.
+	Choose(*rand.Rand) T
+
+	// Shrink returns values that are "smaller" than the given argument, from the most preferred one.
+	// It is used to find a minimal counterexample of a property.
+	// It must not return the argument itself, or shrinking may never terminate.
+	Shrink(T) []T
+}
+
+// DefaultArbitrary is Arbitrary with default implementations.
+type DefaultArbitrary[T any] struct {
+	ChooseImpl func(*rand.Rand) T
+
+	// ShrinkImpl can be nil if T cannot be shrunk.
+	ShrinkImpl func(T) []T
+}
+
+func (a *DefaultArbitrary[T]) Choose(r *rand.Rand) T {
+	return a.ChooseImpl(r)
+}
+
+func (a *DefaultArbitrary[T]) Shrink(x T) []T {
+	if a.ShrinkImpl == nil {
+		return nil
+	}
+	return a.ShrinkImpl(x)
+}
+
+// maxLen is the maximum length of collections generated by SliceOf.
+const maxLen = 16
+
+// Int returns an Arbitrary that generates integers.
+// It generates small numbers more often than large ones, and shrinks values towards zero.
+func Int[T constraints.Integer]() Arbitrary[T] {
+	return &DefaultArbitrary[T]{
+		ChooseImpl: func(r *rand.Rand) T {
+			if r.Intn(4) == 0 {
+				return T(r.Uint64())
+			}
+			var zero T
+			if zero-1 < zero {
+				// signed
+				return T(r.Intn(201) - 100)
+			}
+			return T(r.Intn(101))
+		},
+		ShrinkImpl: func(x T) []T {
+			var candidates []T
+			if x < 0 && 0 < -x {
+				candidates = append(candidates, -x)
+			}
+			// x - x, x - x/2, x - x/4, ..., x - 1 (or x + 1 if x is negative)
+			for i := x; i != 0; i /= 2 {
+				candidates = append(candidates, x-i)
+			}
+			return candidates
+		},
+	}
+}
+
+// Float returns an Arbitrary that generates floating-point numbers.
+// It generates small numbers more often than large ones, and shrinks values towards zero.
+// It never generates NaN or infinities.
+func Float[T constraints.Float]() Arbitrary[T] {
+	return &DefaultArbitrary[T]{
+		ChooseImpl: func(r *rand.Rand) T {
+			switch r.Intn(4) {
+			case 0:
+				return 0
+			case 1:
+				return T(r.NormFloat64() * 1e6)
+			default:
+				return T(r.Float64()*200 - 100)
+			}
+		},
+		ShrinkImpl: func(x T) []T {
+			var candidates []T
+			if x == 0 {
+				return candidates
+			}
+			candidates = append(candidates, 0)
+			if x < 0 {
+				candidates = append(candidates, -x)
+			}
+			if t := T(math.Trunc(float64(x))); t != x {
+				candidates = append(candidates, t)
+			} else if h := T(math.Trunc(float64(x / 2))); h != 0 {
+				candidates = append(candidates, h)
+			}
+			return candidates
+		},
+	}
+}
+
+// Bool returns an Arbitrary that generates booleans.
+// It shrinks true to false.
+func Bool() Arbitrary[bool] {
+	return &DefaultArbitrary[bool]{
+		ChooseImpl: func(r *rand.Rand) bool {
+			return r.Intn(2) == 0
+		},
+		ShrinkImpl: func(x bool) []bool {
+			if x {
+				return []bool{false}
+			}
+			return nil
+		},
+	}
+}
+
+// Rune returns an Arbitrary that generates valid Unicode code points.
+// It generates printable ASCII characters more often than others, and shrinks values towards 'a'.
+func Rune() Arbitrary[rune] {
+	return &DefaultArbitrary[rune]{
+		ChooseImpl: func(r *rand.Rand) rune {
+			if r.Intn(4) == 0 {
+				for {
+					c := rune(r.Intn(utf8.MaxRune + 1))
+					if utf8.ValidRune(c) {
+						return c
+					}
+				}
+			}
+			return rune(' ' + r.Intn('~'-' '+1))
+		},
+		ShrinkImpl: func(x rune) []rune {
+			if x != 'a' {
+				return []rune{'a'}
+			}
+			return nil
+		},
+	}
+}
+
+// String returns an Arbitrary that generates strings that consist of runes generated by Rune().
+// It shrinks values by removing or shrinking their runes.
+func String() Arbitrary[string] {
+	return Convert(SliceOf(Rune()),
+		func(rs []rune) string { return string(rs) },
+		func(s string) []rune { return []rune(s) },
+	)
+}
+
+// SliceOf returns an Arbitrary that generates slices whose elements are generated by a.
+// It shrinks values by removing some of their elements or by shrinking one of their elements.
+func SliceOf[T any](a Arbitrary[T]) Arbitrary[[]T] {
+	return &DefaultArbitrary[[]T]{
+		ChooseImpl: func(r *rand.Rand) []T {
+			n := r.Intn(maxLen + 1)
+			xs := make([]T, 0, n)
+			for i := 0; i < n; i++ {
+				xs = append(xs, a.Choose(r))
+			}
+			return xs
+		},
+		ShrinkImpl: func(xs []T) [][]T {
+			candidates := removals(xs)
+			for i := range xs {
+				for _, x := range a.Shrink(xs[i]) {
+					ys := append([]T{}, xs...)
+					ys[i] = x
+					candidates = append(candidates, ys)
+				}
+			}
+			return candidates
+		},
+	}
+}
+
+// removals returns slices made by removing some elements from xs, from the smallest to the largest.
+func removals[T any](xs []T) [][]T {
+	var candidates [][]T
+	n := len(xs)
+	if n == 0 {
+		return candidates
+	}
+	candidates = append(candidates, []T{})
+	if 2 <= n {
+		candidates = append(candidates, append([]T{}, xs[:n/2]...), append([]T{}, xs[n/2:]...))
+	}
+	if 3 <= n {
+		for i := range xs {
+			ys := append([]T{}, xs[:i]...)
+			candidates = append(candidates, append(ys, xs[i+1:]...))
+		}
+	}
+	return candidates
+}
+
+// Convert returns an Arbitrary[U] from Arbitrary[T] and conversion functions between T and U.
+// It is useful to define an Arbitrary for a type that can be represented by another type.
+func Convert[T, U any](a Arbitrary[T], to func(T) U, from func(U) T) Arbitrary[U] {
+	return &DefaultArbitrary[U]{
+		ChooseImpl: func(r *rand.Rand) U {
+			return to(a.Choose(r))
+		},
+		ShrinkImpl: func(x U) []U {
+			xs := a.Shrink(from(x))
+			ys := make([]U, 0, len(xs))
+			for _, x := range xs {
+				ys = append(ys, to(x))
+			}
+			return ys
+		},
+	}
+}
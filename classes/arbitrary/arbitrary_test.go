@@ -0,0 +1,136 @@
+package arbitrary_test
+
+import (
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+	"unicode/utf8"
+)
+
+// choose returns n values generated by a with a fixed seed.
+func choose[T any](a arbitrary.Arbitrary[T], n int) []T {
+	r := rand.New(rand.NewSource(42))
+	xs := make([]T, 0, n)
+	for i := 0; i < n; i++ {
+		xs = append(xs, a.Choose(r))
+	}
+	return xs
+}
+
+func TestDefaultArbitrary(t *testing.T) {
+	a := &arbitrary.DefaultArbitrary[int]{
+		ChooseImpl: func(r *rand.Rand) int { return r.Intn(10) },
+	}
+	for _, x := range choose[int](a, 100) {
+		assert.True(t, 0 <= x && x < 10)
+	}
+	assert.Empty(t, a.Shrink(5), "it should not shrink when ShrinkImpl is nil")
+}
+
+func TestInt(t *testing.T) {
+	a := arbitrary.Int[int]()
+
+	t.Run("Choose", func(t *testing.T) {
+		assert.Equal(t, choose(a, 100), choose(a, 100), "it should be deterministic")
+		for _, x := range choose(arbitrary.Int[uint8](), 100) {
+			assert.True(t, 0 <= x && x <= 255)
+		}
+	})
+
+	t.Run("Shrink", func(t *testing.T) {
+		assert.Empty(t, a.Shrink(0))
+		assert.Equal(t, a.Shrink(1), []int{0})
+		assert.Equal(t, a.Shrink(10), []int{0, 5, 8, 9})
+		assert.Equal(t, a.Shrink(-10), []int{10, 0, -5, -8, -9})
+		assert.Equal(t, arbitrary.Int[uint]().Shrink(10), []uint{0, 5, 8, 9})
+	})
+}
+
+func TestFloat(t *testing.T) {
+	a := arbitrary.Float[float64]()
+
+	t.Run("Choose", func(t *testing.T) {
+		assert.Equal(t, choose(a, 100), choose(a, 100), "it should be deterministic")
+	})
+
+	t.Run("Shrink", func(t *testing.T) {
+		assert.Empty(t, a.Shrink(0))
+		assert.Equal(t, a.Shrink(1), []float64{0})
+		assert.Equal(t, a.Shrink(2.5), []float64{0, 2})
+		assert.Equal(t, a.Shrink(-4), []float64{0, 4, -2})
+	})
+}
+
+func TestBool(t *testing.T) {
+	a := arbitrary.Bool()
+	assert.ElementsMatch(t, uniq(choose(a, 100)), []bool{true, false})
+	assert.Equal(t, a.Shrink(true), []bool{false})
+	assert.Empty(t, a.Shrink(false))
+}
+
+func TestRune(t *testing.T) {
+	a := arbitrary.Rune()
+	for _, c := range choose(a, 100) {
+		assert.True(t, utf8.ValidRune(c))
+	}
+	assert.Equal(t, a.Shrink('x'), []rune{'a'})
+	assert.Empty(t, a.Shrink('a'))
+}
+
+func TestString(t *testing.T) {
+	a := arbitrary.String()
+	assert.Equal(t, choose(a, 100), choose(a, 100), "it should be deterministic")
+	for _, s := range choose(a, 100) {
+		assert.True(t, utf8.ValidString(s))
+	}
+	assert.Empty(t, a.Shrink(""))
+	assert.Equal(t, a.Shrink("x"), []string{"", "a"})
+}
+
+func TestSliceOf(t *testing.T) {
+	a := arbitrary.SliceOf(arbitrary.Int[int]())
+	assert.Equal(t, choose(a, 100), choose(a, 100), "it should be deterministic")
+	assert.Contains(t, uniq(lens(choose(a, 100))), 0)
+
+	assert.Empty(t, a.Shrink([]int{}))
+	assert.Equal(t, a.Shrink([]int{1}), [][]int{{}, {0}})
+	assert.Equal(t, a.Shrink([]int{1, 2, 3}), [][]int{
+		{}, {1}, {2, 3}, {2, 3}, {1, 3}, {1, 2},
+		{0, 2, 3},
+		{1, 0, 3}, {1, 1, 3},
+		{1, 2, 0}, {1, 2, 2},
+	})
+}
+
+func TestConvert(t *testing.T) {
+	type Celsius int
+	a := arbitrary.Convert(arbitrary.Int[int](),
+		func(x int) Celsius { return Celsius(x) },
+		func(c Celsius) int { return int(c) },
+	)
+	for i, x := range choose(arbitrary.Int[int](), 10) {
+		assert.Equal(t, choose(a, 10)[i], Celsius(x))
+	}
+	assert.Equal(t, a.Shrink(10), []Celsius{0, 5, 8, 9})
+}
+
+func uniq[T comparable](xs []T) []T {
+	seen := map[T]bool{}
+	ys := []T{}
+	for _, x := range xs {
+		if !seen[x] {
+			seen[x] = true
+			ys = append(ys, x)
+		}
+	}
+	return ys
+}
+
+func lens[T any](xss [][]T) []int {
+	ns := make([]int, 0, len(xss))
+	for _, xs := range xss {
+		ns = append(ns, len(xs))
+	}
+	return ns
+}
@@ -0,0 +1,338 @@
+// Package prop provides a property-based testing runner built on top of arbitrary.Arbitrary.
+//
+// Each property is checked against randomly generated values, and a counterexample is shrunk and
+// reported through debug.Debug when the property doesn't hold.
+// The seed is reported together with the counterexample, and the same failure can be reproduced by
+// running tests with the -prop.seed flag or the DOGS_PROP_SEED environment variable, e.g.:
+//
+//	go test ./... -prop.seed=1234
+//	DOGS_PROP_SEED=1234 go test ./...
+//
+// This package is meant to be used in tests.
+// The -prop.seed and -prop.n flags are only registered in test binaries,
+// and they are not registered if flags with the same names are already defined.
+package prop
+
+import (
+	"flag"
+	"fmt"
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/types/pair"
+	"math/rand"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// SeedEnv is the name of the environment variable to set the seed of random values.
+const SeedEnv = "DOGS_PROP_SEED"
+
+// DefaultIterations is the default number of random samples to check each property against.
+const DefaultIterations = 100
+
+// DefaultMaxShrinks is the default maximum number of shrinking steps.
+const DefaultMaxShrinks = 1000
+
+var (
+	flagSeed       *int64
+	flagIterations *int
+)
+
+func init() {
+	if !testing.Testing() {
+		return
+	}
+	if flag.Lookup("prop.seed") == nil {
+		flagSeed = flag.Int64("prop.seed", 0, "seed of random values used in property-based tests (0 means a random seed)")
+	}
+	if flag.Lookup("prop.n") == nil {
+		flagIterations = flag.Int("prop.n", DefaultIterations, "number of random samples to check each property against")
+	}
+}
+
+// Config configures how properties are checked.
+// The zero value is a valid Config that uses the default values.
+type Config struct {
+	// Iterations is the number of random samples to check each property against.
+	// The value of -prop.n flag, or DefaultIterations if the flag is not available, is used if this is zero.
+	// Check fails if the resulting number is not positive.
+	Iterations int
+
+	// Seed is the seed of random values.
+	// If this is zero, the value of -prop.seed flag or DOGS_PROP_SEED environment variable is used if given,
+	// or a random seed is used otherwise.
+	Seed int64
+
+	// MaxShrinks is the maximum number of shrinking steps.
+	// DefaultMaxShrinks is used if this is zero, and Check fails if this is negative.
+	MaxShrinks int
+}
+
+func (c Config) iterations() (int, error) {
+	n := c.Iterations
+	if n == 0 {
+		n = DefaultIterations
+		if flagIterations != nil {
+			n = *flagIterations
+		}
+	}
+	if n <= 0 {
+		return 0, fmt.Errorf("number of iterations must be positive: %d", n)
+	}
+	return n, nil
+}
+
+func (c Config) maxShrinks() (int, error) {
+	if c.MaxShrinks < 0 {
+		return 0, fmt.Errorf("maximum number of shrinks must not be negative: %d", c.MaxShrinks)
+	}
+	if c.MaxShrinks != 0 {
+		return c.MaxShrinks, nil
+	}
+	return DefaultMaxShrinks, nil
+}
+
+func (c Config) seed() (int64, error) {
+	if c.Seed != 0 {
+		return c.Seed, nil
+	}
+	if flagSeed != nil && *flagSeed != 0 {
+		return *flagSeed, nil
+	}
+	if env := os.Getenv(SeedEnv); env != "" {
+		seed, err := strconv.ParseInt(env, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid %s: %w", SeedEnv, err)
+		}
+		return seed, nil
+	}
+	return time.Now().UnixNano(), nil
+}
+
+// Property is a property that should hold for every randomly generated value.
+type Property struct {
+	name  string
+	check func(r *rand.Rand, iterations, maxShrinks int) *failure
+}
+
+type failure struct {
+	counterexample string
+	numTests       int
+	numShrinks     int
+	panic          any
+}
+
+// Named returns a copy of p that is reported with the given name.
+func (p Property) Named(name string) Property {
+	p.name = name
+	return p
+}
+
+// Prop returns a Property that fn returns true for every value generated by arb.
+// A counterexample is formatted by dbg.
+// A panic in fn is considered as a failure.
+func Prop[T any](arb arbitrary.Arbitrary[T], dbg debug.Debug[T], fn func(T) bool) Property {
+	return PropFmt(arb, dbg, func(x T) (bool, func() string) {
+		return fn(x), nil
+	})
+}
+
+// PropFmt is the same as Prop except that fn also returns a function that describes why the property doesn't hold.
+// The description is only computed for the final counterexample, and is reported after the counterexample formatted by dbg.
+// The function can refer to values that fn has already computed so that they are not computed again.
+// A panic in fn, dbg or the returned function is considered as a failure.
+func PropFmt[T any](arb arbitrary.Arbitrary[T], dbg debug.Debug[T], fn func(T) (bool, func() string)) Property {
+	return Property{
+		name: "property",
+		check: func(r *rand.Rand, iterations, maxShrinks int) *failure {
+			for i := 1; i <= iterations; i++ {
+				x := arb.Choose(r)
+				if res := run(fn, x); res.ok {
+					continue
+				}
+				x, numShrinks, res := shrink(arb, fn, x, maxShrinks)
+				counterexample, p := describe(dbg, x, res.describe)
+				if res.panic != nil {
+					p = res.panic
+				}
+				return &failure{
+					counterexample: counterexample,
+					numTests:       i,
+					numShrinks:     numShrinks,
+					panic:          p,
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// Prop2 is the same as Prop except that fn takes two arguments.
+func Prop2[T, U any](
+	arbT arbitrary.Arbitrary[T], arbU arbitrary.Arbitrary[U],
+	dbgT debug.Debug[T], dbgU debug.Debug[U],
+	fn func(T, U) bool,
+) Property {
+	type P = pair.Pair[T, U]
+	return Prop(
+		pair.DerivePairArbitrary(arbT, arbU),
+		&debug.DefaultDebug[P]{
+			DebugFmtImpl: func(p P) string {
+				return formatArgs(dbgT.DebugFmt(p.First), dbgU.DebugFmt(p.Second))
+			},
+		},
+		func(p P) bool {
+			return fn(p.First, p.Second)
+		},
+	)
+}
+
+// Prop3 is the same as Prop except that fn takes three arguments.
+func Prop3[T, U, V any](
+	arbT arbitrary.Arbitrary[T], arbU arbitrary.Arbitrary[U], arbV arbitrary.Arbitrary[V],
+	dbgT debug.Debug[T], dbgU debug.Debug[U], dbgV debug.Debug[V],
+	fn func(T, U, V) bool,
+) Property {
+	type P = pair.Pair[T, pair.Pair[U, V]]
+	return Prop(
+		pair.DerivePairArbitrary(arbT, pair.DerivePairArbitrary(arbU, arbV)),
+		&debug.DefaultDebug[P]{
+			DebugFmtImpl: func(p P) string {
+				return formatArgs(dbgT.DebugFmt(p.First), dbgU.DebugFmt(p.Second.First), dbgV.DebugFmt(p.Second.Second))
+			},
+		},
+		func(p P) bool {
+			return fn(p.First, p.Second.First, p.Second.Second)
+		},
+	)
+}
+
+func formatArgs(args ...string) string {
+	var msg string
+	for i, arg := range args {
+		msg += fmt.Sprintf("\n  #%d: %s", i+1, arg)
+	}
+	return msg
+}
+
+// shrink repeatedly replaces x with the first shrink candidate that still fails until no candidates fail.
+func shrink[T any](arb arbitrary.Arbitrary[T], fn func(T) (bool, func() string), x T, maxShrinks int) (T, int, result) {
+	res := run(fn, x)
+	numShrinks := 0
+outer:
+	for numShrinks < maxShrinks {
+		for _, y := range arb.Shrink(x) {
+			if r := run(fn, y); !r.ok {
+				x, res = y, r
+				numShrinks++
+				continue outer
+			}
+		}
+		break
+	}
+	return x, numShrinks, res
+}
+
+type result struct {
+	ok       bool
+	describe func() string
+	panic    any
+}
+
+// run returns the result of fn(x).
+// It returns a failed result with the recovered value if fn panics.
+func run[T any](fn func(T) (bool, func() string), x T) (res result) {
+	defer func() {
+		if p := recover(); p != nil {
+			res = result{panic: p}
+		}
+	}()
+	ok, d := fn(x)
+	return result{ok: ok, describe: d}
+}
+
+// describe formats a counterexample x using dbg, followed by the result of d if it is not nil.
+// It returns the recovered value if either of them panics.
+func describe[T any](dbg debug.Debug[T], x T, d func() string) (msg string, recovered any) {
+	defer func() {
+		if p := recover(); p != nil {
+			msg += "<panic while formatting>"
+			recovered = p
+		}
+	}()
+	msg = dbg.DebugFmt(x)
+	if d != nil {
+		msg += "\n" + d()
+	}
+	return msg, nil
+}
+
+// Check checks that p holds, and reports a counterexample if it doesn't.
+// It returns true if and only if p holds.
+func (c Config) Check(t testing.TB, p Property) bool {
+	t.Helper()
+	seed, err := c.seed()
+	if err != nil {
+		t.Errorf("%s: %s", p.name, err.Error())
+		return false
+	}
+	iterations, err := c.iterations()
+	if err != nil {
+		t.Errorf("%s: %s", p.name, err.Error())
+		return false
+	}
+	maxShrinks, err := c.maxShrinks()
+	if err != nil {
+		t.Errorf("%s: %s", p.name, err.Error())
+		return false
+	}
+	f := p.check(rand.New(rand.NewSource(seed)), iterations, maxShrinks)
+	if f == nil {
+		return true
+	}
+	msg := fmt.Sprintf("%s: failed after %d tests and %d shrinks\ncounterexample: %s",
+		p.name, f.numTests, f.numShrinks, f.counterexample)
+	if f.panic != nil {
+		msg += fmt.Sprintf("\npanic: %v", f.panic)
+	}
+	msg += fmt.Sprintf("\nrerun with -prop.seed=%d or %s=%d to reproduce", seed, SeedEnv, seed)
+	t.Errorf("%s", msg)
+	return false
+}
+
+// Check checks that p holds with the default Config.
+func Check(t testing.TB, p Property) bool {
+	t.Helper()
+	return Config{}.Check(t, p)
+}
+
+// ForAll checks that fn returns true for every value generated by arb.
+// It is a shorthand for Check(t, Prop(arb, dbg, fn)).
+func ForAll[T any](t testing.TB, arb arbitrary.Arbitrary[T], dbg debug.Debug[T], fn func(T) bool) bool {
+	t.Helper()
+	return Check(t, Prop(arb, dbg, fn))
+}
+
+// ForAll2 is the same as ForAll except that fn takes two arguments.
+func ForAll2[T, U any](
+	t testing.TB,
+	arbT arbitrary.Arbitrary[T], arbU arbitrary.Arbitrary[U],
+	dbgT debug.Debug[T], dbgU debug.Debug[U],
+	fn func(T, U) bool,
+) bool {
+	t.Helper()
+	return Check(t, Prop2(arbT, arbU, dbgT, dbgU, fn))
+}
+
+// ForAll3 is the same as ForAll except that fn takes three arguments.
+func ForAll3[T, U, V any](
+	t testing.TB,
+	arbT arbitrary.Arbitrary[T], arbU arbitrary.Arbitrary[U], arbV arbitrary.Arbitrary[V],
+	dbgT debug.Debug[T], dbgU debug.Debug[U], dbgV debug.Debug[V],
+	fn func(T, U, V) bool,
+) bool {
+	t.Helper()
+	return Check(t, Prop3(arbT, arbU, arbV, dbgT, dbgU, dbgV, fn))
+}
@@ -0,0 +1,195 @@
+package prop_test
+
+import (
+	"fmt"
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/arbitrary/prop"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// fakeT records errors instead of failing the test.
+type fakeT struct {
+	testing.TB
+	errors []string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+var (
+	arbInt = arbitrary.Int[int]()
+	dbgInt = debug.DeriveDebug[int]()
+)
+
+func TestForAll(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		ok := prop.ForAll(t, arbInt, dbgInt, func(x int) bool {
+			return x+0 == x
+		})
+		assert.True(t, ok)
+	})
+
+	t.Run("shrinks a counterexample", func(t *testing.T) {
+		fake := &fakeT{TB: t}
+		ok := prop.ForAll(fake, arbInt, dbgInt, func(x int) bool {
+			return x < 10
+		})
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 1)
+		assert.Contains(t, fake.errors[0], "property: failed after")
+		assert.Contains(t, fake.errors[0], "counterexample: 10")
+		assert.Contains(t, fake.errors[0], "-prop.seed=")
+		assert.Contains(t, fake.errors[0], prop.SeedEnv+"=")
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		fake := &fakeT{TB: t}
+		ok := prop.ForAll(fake, arbInt, dbgInt, func(x int) bool {
+			if x != 0 {
+				panic("not zero")
+			}
+			return true
+		})
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 1)
+		assert.Contains(t, fake.errors[0], "counterexample: 1")
+		assert.Contains(t, fake.errors[0], "panic: not zero")
+	})
+}
+
+func TestPropFmt(t *testing.T) {
+	t.Run("description", func(t *testing.T) {
+		fake := &fakeT{TB: t}
+		ok := prop.Check(fake, prop.PropFmt(arbInt, dbgInt, func(x int) (bool, func() string) {
+			return x < 10, func() string { return fmt.Sprintf("x - 10 = %d", x-10) }
+		}))
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 1)
+		assert.Contains(t, fake.errors[0], "counterexample: 10\nx - 10 = 0")
+	})
+
+	t.Run("panic in description", func(t *testing.T) {
+		fake := &fakeT{TB: t}
+		ok := prop.Check(fake, prop.PropFmt(arbInt, dbgInt, func(x int) (bool, func() string) {
+			return x < 10, func() string { panic("cannot describe") }
+		}))
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 1)
+		assert.Contains(t, fake.errors[0], "counterexample: 10")
+		assert.Contains(t, fake.errors[0], "panic: cannot describe")
+	})
+
+	t.Run("panic in Debug", func(t *testing.T) {
+		fake := &fakeT{TB: t}
+		dbg := &debug.DefaultDebug[int]{
+			DebugFmtImpl: func(int) string { panic("cannot format") },
+		}
+		ok := prop.ForAll(fake, arbInt, dbg, func(x int) bool { return x < 10 })
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 1)
+		assert.Contains(t, fake.errors[0], "panic: cannot format")
+	})
+}
+
+func TestForAll2(t *testing.T) {
+	fake := &fakeT{TB: t}
+	ok := prop.ForAll2(fake, arbInt, arbitrary.String(), dbgInt, debug.DeriveDebug[string](),
+		func(x int, s string) bool {
+			return x < 1 || len(s) < 1
+		},
+	)
+	assert.False(t, ok)
+	assert.Equal(t, len(fake.errors), 1)
+	assert.Contains(t, fake.errors[0], "#1: 1\n  #2: \"a\"")
+}
+
+func TestForAll3(t *testing.T) {
+	fake := &fakeT{TB: t}
+	ok := prop.ForAll3(fake, arbInt, arbInt, arbInt, dbgInt, dbgInt, dbgInt,
+		func(x, y, z int) bool {
+			return x+y+z < 3
+		},
+	)
+	assert.False(t, ok)
+	assert.Equal(t, len(fake.errors), 1)
+	assert.Contains(t, fake.errors[0], "#1: ")
+	assert.Contains(t, fake.errors[0], "#3: ")
+}
+
+func TestConfig_Check(t *testing.T) {
+	t.Run("iterations", func(t *testing.T) {
+		count := 0
+		ok := prop.Config{Iterations: 42}.Check(t, prop.Prop(arbInt, dbgInt, func(int) bool {
+			count++
+			return true
+		}))
+		assert.True(t, ok)
+		assert.Equal(t, count, 42)
+	})
+
+	t.Run("seed", func(t *testing.T) {
+		run := func() []int {
+			var xs []int
+			prop.Config{Seed: 1234}.Check(t, prop.Prop(arbInt, dbgInt, func(x int) bool {
+				xs = append(xs, x)
+				return true
+			}))
+			return xs
+		}
+		assert.Equal(t, run(), run())
+	})
+
+	t.Run("seed from environment variable", func(t *testing.T) {
+		t.Setenv(prop.SeedEnv, "1234")
+		fake := &fakeT{TB: t}
+		prop.ForAll(fake, arbInt, dbgInt, func(int) bool { return false })
+		assert.Equal(t, len(fake.errors), 1)
+		assert.Contains(t, fake.errors[0], "-prop.seed=1234")
+	})
+
+	t.Run("invalid environment variable", func(t *testing.T) {
+		t.Setenv(prop.SeedEnv, "foo")
+		fake := &fakeT{TB: t}
+		ok := prop.ForAll(fake, arbInt, dbgInt, func(int) bool { return true })
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 1)
+		assert.Contains(t, fake.errors[0], prop.SeedEnv)
+	})
+
+	t.Run("non-positive iterations", func(t *testing.T) {
+		fake := &fakeT{TB: t}
+		ok := prop.Config{Iterations: -1}.Check(fake, prop.Prop(arbInt, dbgInt, func(int) bool { return true }))
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 1)
+		assert.Contains(t, fake.errors[0], "number of iterations must be positive")
+	})
+
+	t.Run("negative max shrinks", func(t *testing.T) {
+		fake := &fakeT{TB: t}
+		ok := prop.Config{MaxShrinks: -1}.Check(fake, prop.Prop(arbInt, dbgInt, func(int) bool { return true }))
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 1)
+		assert.Contains(t, fake.errors[0], "maximum number of shrinks")
+	})
+
+	t.Run("max shrinks", func(t *testing.T) {
+		fake := &fakeT{TB: t}
+		prop.Config{Seed: 1, MaxShrinks: 1}.Check(fake, prop.Prop(arbInt, dbgInt, func(x int) bool {
+			return x < 10
+		}))
+		assert.Equal(t, len(fake.errors), 1)
+		assert.Contains(t, fake.errors[0], "and 1 shrinks")
+	})
+}
+
+func TestProperty_Named(t *testing.T) {
+	fake := &fakeT{TB: t}
+	prop.Check(fake, prop.Prop(arbInt, dbgInt, func(int) bool { return false }).Named("falsity"))
+	assert.Equal(t, len(fake.errors), 1)
+	assert.Contains(t, fake.errors[0], "falsity: failed after 1 tests")
+}
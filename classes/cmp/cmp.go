@@ -138,6 +138,7 @@ func (o *DefaultOrd[T]) Ne(x, y T) bool {
 	return result != EQ
 }
 
+// Ordering is the result of comparison.
 type Ordering int
 
 const (
@@ -146,6 +147,50 @@ const (
 	GT
 )
 
+// FromInt converts an integer that follows the convention of the standard library (e.g. strings.Compare) into Ordering.
+// It returns LT if i is negative, EQ if i is zero, and GT if i is positive.
+func FromInt(i int) Ordering {
+	if i < 0 {
+		return LT
+	} else if i == 0 {
+		return EQ
+	} else {
+		return GT
+	}
+}
+
+// Int converts o into an integer that follows the convention of the standard library (e.g. slices.SortFunc).
+// It returns -1 if o is LT, 0 if o is EQ, and 1 if o is GT.
+func (o Ordering) Int() int {
+	return int(o) - int(EQ)
+}
+
+// Reverse returns GT if o is LT, LT if o is GT, and o itself otherwise.
+func (o Ordering) Reverse() Ordering {
+	switch o {
+	case LT:
+		return GT
+	case GT:
+		return LT
+	default:
+		return o
+	}
+}
+
+// Then returns o unless it is EQ, or other otherwise.
+// It is useful to compare values by multiple keys.
+func (o Ordering) Then(other Ordering) Ordering {
+	if o != EQ {
+		return o
+	}
+	return other
+}
+
+// String returns the name of o, that is, "LT", "EQ" or "GT".
+func (o Ordering) String() string {
+	return o.GoString()
+}
+
 func (o Ordering) GoString() string {
 	switch o {
 	case LT:
@@ -158,3 +203,10 @@ func (o Ordering) GoString() string {
 		return fmt.Sprintf("<unknown Ordering (%d)>", o)
 	}
 }
+
+// CompareFunc returns a comparison function that can be passed to the standard library (e.g. slices.SortFunc).
+func CompareFunc[T any](ord Ord[T]) func(T, T) int {
+	return func(x, y T) int {
+		return ord.Compare(x, y).Int()
+	}
+}
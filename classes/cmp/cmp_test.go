@@ -1,7 +1,10 @@
 package cmp_test
 
 import (
+	"fmt"
 	"github.com/genkami/dogs/classes/cmp"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -84,3 +87,48 @@ func testIntOrd(t *testing.T, intOrd cmp.Ord[int]) {
 		assert.True(t, subject(123, 122))
 	})
 }
+
+func TestOrdering(t *testing.T) {
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, cmp.LT.String(), "LT")
+		assert.Equal(t, cmp.EQ.String(), "EQ")
+		assert.Equal(t, cmp.GT.String(), "GT")
+		assert.Equal(t, fmt.Sprint(cmp.GT), "GT")
+	})
+
+	t.Run("Reverse", func(t *testing.T) {
+		assert.Equal(t, cmp.LT.Reverse(), cmp.GT)
+		assert.Equal(t, cmp.EQ.Reverse(), cmp.EQ)
+		assert.Equal(t, cmp.GT.Reverse(), cmp.LT)
+	})
+
+	t.Run("Then", func(t *testing.T) {
+		assert.Equal(t, cmp.LT.Then(cmp.GT), cmp.LT)
+		assert.Equal(t, cmp.GT.Then(cmp.LT), cmp.GT)
+		assert.Equal(t, cmp.EQ.Then(cmp.LT), cmp.LT)
+		assert.Equal(t, cmp.EQ.Then(cmp.EQ), cmp.EQ)
+	})
+
+	t.Run("Int", func(t *testing.T) {
+		assert.Equal(t, cmp.LT.Int(), -1)
+		assert.Equal(t, cmp.EQ.Int(), 0)
+		assert.Equal(t, cmp.GT.Int(), 1)
+	})
+}
+
+func TestFromInt(t *testing.T) {
+	assert.Equal(t, cmp.FromInt(-3), cmp.LT)
+	assert.Equal(t, cmp.FromInt(0), cmp.EQ)
+	assert.Equal(t, cmp.FromInt(5), cmp.GT)
+	assert.Equal(t, cmp.FromInt(strings.Compare("a", "b")), cmp.LT)
+}
+
+func TestCompareFunc(t *testing.T) {
+	xs := []int{3, 1, 2}
+	slices.SortFunc(xs, cmp.CompareFunc(cmp.Reverse(cmp.DeriveOrd[int]())))
+	assert.Equal(t, xs, []int{3, 2, 1})
+
+	i, found := slices.BinarySearchFunc([]int{1, 3, 5}, 3, cmp.CompareFunc(cmp.DeriveOrd[int]()))
+	assert.True(t, found)
+	assert.Equal(t, i, 1)
+}
@@ -0,0 +1,157 @@
+// Package cmptest provides functions to check that instances of type classes in cmp satisfy their laws.
+package cmptest
+
+import (
+	"fmt"
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/arbitrary/prop"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/types/pair"
+	"math/rand"
+	"testing"
+)
+
+type triple[T any] = pair.Pair[T, pair.Pair[T, T]]
+
+// AssertEq checks that eq satisfies the laws of Eq using random values generated by arb:
+//
+//	Equal(x, x)
+//	Equal(x, y) implies Equal(y, x)
+//	Equal(x, y) and Equal(y, z) implies Equal(x, z)
+//
+// It reports shrunk counterexamples formatted by dbg and returns false if any of the laws doesn't hold.
+func AssertEq[T any](t testing.TB, eq cmp.Eq[T], arb arbitrary.Arbitrary[T], dbg debug.Debug[T]) bool {
+	t.Helper()
+	triples := deriveTripleArbitrary(arb)
+	dbgTriple := debugTriple(dbg)
+	ok := prop.Check(t, prop.Prop(arb, debugSingle(dbg),
+		func(x T) bool {
+			return eq.Equal(x, x)
+		},
+	).Named("reflexivity"))
+	ok = prop.Check(t, prop.Prop(triples, dbgTriple,
+		func(p triple[T]) bool {
+			x, y := p.First, p.Second.First
+			return eq.Equal(x, y) == eq.Equal(y, x)
+		},
+	).Named("symmetry")) && ok
+	ok = prop.Check(t, prop.Prop(triples, dbgTriple,
+		func(p triple[T]) bool {
+			x, y, z := p.First, p.Second.First, p.Second.Second
+			return !(eq.Equal(x, y) && eq.Equal(y, z)) || eq.Equal(x, z)
+		},
+	).Named("transitivity")) && ok
+	return ok
+}
+
+// AssertOrd checks that ord satisfies the laws of Ord using random values generated by arb:
+//
+//	Le(x, y) or Le(y, x)
+//	Le(x, y) and Le(y, x) implies Eq(x, y)
+//	Le(x, y) and Le(y, z) implies Le(x, z)
+//
+// It also checks that Compare(x, y) is the reverse of Compare(y, x),
+// and that Lt, Le, Gt, Ge, Eq and Ne are consistent with Compare.
+// It reports shrunk counterexamples formatted by dbg and returns false if any of the laws doesn't hold.
+func AssertOrd[T any](t testing.TB, ord cmp.Ord[T], arb arbitrary.Arbitrary[T], dbg debug.Debug[T]) bool {
+	t.Helper()
+	triples := deriveTripleArbitrary(arb)
+	dbgTriple := debugTriple(dbg)
+	ok := prop.Check(t, prop.Prop(triples, dbgTriple,
+		func(p triple[T]) bool {
+			x, y := p.First, p.Second.First
+			return ord.Le(x, y) || ord.Le(y, x)
+		},
+	).Named("totality"))
+	ok = prop.Check(t, prop.Prop(triples, dbgTriple,
+		func(p triple[T]) bool {
+			x, y := p.First, p.Second.First
+			return !(ord.Le(x, y) && ord.Le(y, x)) || ord.Eq(x, y)
+		},
+	).Named("antisymmetry")) && ok
+	ok = prop.Check(t, prop.Prop(triples, dbgTriple,
+		func(p triple[T]) bool {
+			x, y, z := p.First, p.Second.First, p.Second.Second
+			return !(ord.Le(x, y) && ord.Le(y, z)) || ord.Le(x, z)
+		},
+	).Named("transitivity")) && ok
+	ok = prop.Check(t, prop.Prop(triples, dbgTriple,
+		func(p triple[T]) bool {
+			x, y := p.First, p.Second.First
+			return ord.Compare(x, y).Reverse() == ord.Compare(y, x)
+		},
+	).Named("reversal")) && ok
+	ok = prop.Check(t, prop.PropFmt(triples, dbgTriple,
+		func(p triple[T]) (bool, func() string) {
+			x, y := p.First, p.Second.First
+			c := ord.Compare(x, y)
+			lt, le, gt, ge, eq, ne := ord.Lt(x, y), ord.Le(x, y), ord.Gt(x, y), ord.Ge(x, y), ord.Eq(x, y), ord.Ne(x, y)
+			ok := (c == cmp.LT || c == cmp.EQ || c == cmp.GT) &&
+				lt == (c == cmp.LT) &&
+				le == (c != cmp.GT) &&
+				gt == (c == cmp.GT) &&
+				ge == (c != cmp.LT) &&
+				eq == (c == cmp.EQ) &&
+				ne == (c != cmp.EQ)
+			return ok, func() string {
+				return fmt.Sprintf("Compare(x, y) = %#v, Lt = %t, Le = %t, Gt = %t, Ge = %t, Eq = %t, Ne = %t",
+					c, lt, le, gt, ge, eq, ne)
+			}
+		},
+	).Named("consistency with Compare")) && ok
+	return ok
+}
+
+// deriveTripleArbitrary derives Arbitrary of triples from arb.
+// Since independently generated values are rarely equal to each other,
+// it sometimes reuses the same value so that the laws about equality are actually tested.
+func deriveTripleArbitrary[T any](arb arbitrary.Arbitrary[T]) arbitrary.Arbitrary[triple[T]] {
+	independent := pair.DerivePairArbitrary(arb, pair.DerivePairArbitrary(arb, arb))
+	return &arbitrary.DefaultArbitrary[triple[T]]{
+		ChooseImpl: func(r *rand.Rand) triple[T] {
+			p := independent.Choose(r)
+			if r.Intn(2) == 0 {
+				p.Second.First = p.First
+			}
+			if r.Intn(2) == 0 {
+				p.Second.Second = p.Second.First
+			}
+			return p
+		},
+		ShrinkImpl: func(p triple[T]) []triple[T] {
+			// Try shrinking values together first since they may have been the same value.
+			var candidates []triple[T]
+			x, y, z := p.First, p.Second.First, p.Second.Second
+			for _, c := range arb.Shrink(x) {
+				candidates = append(candidates, newTriple(c, c, c), newTriple(c, c, z))
+			}
+			for _, c := range arb.Shrink(y) {
+				candidates = append(candidates, newTriple(x, c, c))
+			}
+			return append(candidates, independent.Shrink(p)...)
+		},
+	}
+}
+
+func newTriple[T any](x, y, z T) triple[T] {
+	return triple[T]{First: x, Second: pair.Pair[T, T]{First: y, Second: z}}
+}
+
+func debugSingle[T any](dbg debug.Debug[T]) debug.Debug[T] {
+	return &debug.DefaultDebug[T]{
+		DebugFmtImpl: func(x T) string {
+			return "x = " + dbg.DebugFmt(x)
+		},
+	}
+}
+
+func debugTriple[T any](dbg debug.Debug[T]) debug.Debug[triple[T]] {
+	return &debug.DefaultDebug[triple[T]]{
+		DebugFmtImpl: func(p triple[T]) string {
+			return "x = " + dbg.DebugFmt(p.First) +
+				", y = " + dbg.DebugFmt(p.Second.First) +
+				", z = " + dbg.DebugFmt(p.Second.Second)
+		},
+	}
+}
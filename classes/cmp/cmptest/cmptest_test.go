@@ -0,0 +1,106 @@
+package cmptest_test
+
+import (
+	"fmt"
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/cmp/cmptest"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/types/pair"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// fakeT records errors instead of failing the test.
+type fakeT struct {
+	testing.TB
+	errors []string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...any) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertEq(t *testing.T) {
+	arb := arbitrary.Int[int]()
+	dbg := debug.DeriveDebug[int]()
+
+	t.Run("ok", func(t *testing.T) {
+		cmptest.AssertEq(t, cmp.DeriveEq[int](), arb, dbg)
+		cmptest.AssertEq(t, cmp.DeriveEq[string](), arbitrary.String(), debug.DeriveDebug[string]())
+	})
+
+	t.Run("not symmetric", func(t *testing.T) {
+		eq := &cmp.DefaultEq[int]{
+			EqualImpl: func(x, y int) bool { return x <= y },
+		}
+		fake := &fakeT{TB: t}
+		ok := cmptest.AssertEq[int](fake, eq, arb, dbg)
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 1)
+		assert.Contains(t, fake.errors[0], "symmetry")
+	})
+}
+
+func TestAssertOrd(t *testing.T) {
+	arb := arbitrary.Int[int]()
+	dbg := debug.DeriveDebug[int]()
+
+	t.Run("ok", func(t *testing.T) {
+		cmptest.AssertOrd(t, cmp.DeriveOrd[int](), arb, dbg)
+		cmptest.AssertOrd(t, cmp.DeriveOrd[string](), arbitrary.String(), debug.DeriveDebug[string]())
+		cmptest.AssertOrd(t,
+			pair.DerivePairOrd(cmp.DeriveOrd[int](), cmp.DeriveOrd[string]()),
+			pair.DerivePairArbitrary(arb, arbitrary.String()),
+			pair.DerivePairDebug(dbg, debug.DeriveDebug[string]()),
+		)
+	})
+
+	t.Run("never equal", func(t *testing.T) {
+		ord := &cmp.DefaultOrd[int]{
+			CompareImpl: func(x, y int) cmp.Ordering {
+				if x < y {
+					return cmp.LT
+				}
+				return cmp.GT
+			},
+		}
+		fake := &fakeT{TB: t}
+		ok := cmptest.AssertOrd[int](fake, ord, arb, dbg)
+		assert.False(t, ok)
+		assert.Contains(t, fake.errors[0], "totality")
+		assert.Contains(t, fake.errors[0], "x = 0, y = 0")
+	})
+
+	t.Run("inconsistent", func(t *testing.T) {
+		fake := &fakeT{TB: t}
+		ok := cmptest.AssertOrd[int](fake, inconsistentOrd{cmp.DeriveOrd[int]()}, arb, dbg)
+		assert.False(t, ok)
+		assert.Equal(t, len(fake.errors), 1)
+		assert.Contains(t, fake.errors[0], "consistency with Compare")
+		assert.Contains(t, fake.errors[0], "Ne = true")
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		ord := &cmp.DefaultOrd[int]{
+			CompareImpl: func(x, y int) cmp.Ordering {
+				return cmp.FromInt(x / y)
+			},
+		}
+		fake := &fakeT{TB: t}
+		ok := cmptest.AssertOrd[int](fake, ord, arb, dbg)
+		assert.False(t, ok)
+		assert.Contains(t, fake.errors[0], "divide by zero")
+	})
+}
+
+// inconsistentOrd has Ne that doesn't agree with Compare.
+type inconsistentOrd struct {
+	cmp.Ord[int]
+}
+
+func (inconsistentOrd) Ne(x, y int) bool {
+	return true
+}
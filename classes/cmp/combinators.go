@@ -0,0 +1,97 @@
+package cmp
+
+import "golang.org/x/exp/constraints"
+
+// Reverse returns an Ord that orders values in the opposite order of ord.
+func Reverse[T any](ord Ord[T]) Ord[T] {
+	return &DefaultOrd[T]{
+		CompareImpl: func(x, y T) Ordering {
+			return ord.Compare(y, x)
+		},
+	}
+}
+
+// Comparing returns an Ord that orders values by comparing their keys using ord.
+func Comparing[T, K any](key func(T) K, ord Ord[K]) Ord[T] {
+	return &DefaultOrd[T]{
+		CompareImpl: func(x, y T) Ordering {
+			return ord.Compare(key(x), key(y))
+		},
+	}
+}
+
+// ThenBy returns an Ord that orders values by first, and then by second if first considers them equal.
+func ThenBy[T any](first, second Ord[T]) Ord[T] {
+	return Lexicographic(first, second)
+}
+
+// Lexicographic returns an Ord that orders values by the first Ord in ords that doesn't consider them equal.
+// It considers every pair of values equal if ords is empty.
+func Lexicographic[T any](ords ...Ord[T]) Ord[T] {
+	return &DefaultOrd[T]{
+		CompareImpl: func(x, y T) Ordering {
+			for _, ord := range ords {
+				if result := ord.Compare(x, y); result != EQ {
+					return result
+				}
+			}
+			return EQ
+		},
+	}
+}
+
+// EqOn returns an Eq that considers two values equal if and only if their keys are equal in terms of eq.
+func EqOn[T, K any](key func(T) K, eq Eq[K]) Eq[T] {
+	return &DefaultEq[T]{
+		EqualImpl: func(x, y T) bool {
+			return eq.Equal(key(x), key(y))
+		},
+	}
+}
+
+// EqAll returns an Eq that considers two values equal if and only if all of eqs consider them equal.
+// It considers every pair of values equal if eqs is empty.
+func EqAll[T any](eqs ...Eq[T]) Eq[T] {
+	return &DefaultEq[T]{
+		EqualImpl: func(x, y T) bool {
+			for _, eq := range eqs {
+				if !eq.Equal(x, y) {
+					return false
+				}
+			}
+			return true
+		},
+	}
+}
+
+// FromLess returns an Ord from a strict weak ordering `less`, such as one passed to sort.Slice.
+// Two values x and y are considered equal if neither less(x, y) nor less(y, x) holds.
+func FromLess[T any](less func(T, T) bool) Ord[T] {
+	return &DefaultOrd[T]{
+		CompareImpl: func(x, y T) Ordering {
+			if less(x, y) {
+				return LT
+			} else if less(y, x) {
+				return GT
+			} else {
+				return EQ
+			}
+		},
+	}
+}
+
+// FromCompareInt returns an Ord from a comparison function that follows the convention of the standard library,
+// such as strings.Compare.
+func FromCompareInt[T any](compare func(T, T) int) Ord[T] {
+	return &DefaultOrd[T]{
+		CompareImpl: func(x, y T) Ordering {
+			return FromInt(compare(x, y))
+		},
+	}
+}
+
+// FromKey returns an Ord that orders values by their keys using `<`, `==` and `>`.
+// It is a shorthand for Comparing(key, DeriveOrd[K]()).
+func FromKey[T any, K constraints.Ordered](key func(T) K) Ord[T] {
+	return Comparing(key, DeriveOrd[K]())
+}
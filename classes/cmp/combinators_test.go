@@ -0,0 +1,114 @@
+package cmp_test
+
+import (
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/cmp/cmptest"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/types/pair"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func personName(p person) string { return p.name }
+
+func personAge(p person) int { return p.age }
+
+func TestReverse(t *testing.T) {
+	ord := cmp.Reverse(cmp.DeriveOrd[int]())
+	assert.Equal(t, ord.Compare(1, 2), cmp.GT)
+	assert.Equal(t, ord.Compare(1, 1), cmp.EQ)
+	assert.Equal(t, ord.Compare(2, 1), cmp.LT)
+	cmptest.AssertOrd(t, ord, arbitrary.Int[int](), debug.DeriveDebug[int]())
+}
+
+func TestComparing(t *testing.T) {
+	ord := cmp.Comparing(personAge, cmp.DeriveOrd[int]())
+	assert.Equal(t, ord.Compare(person{"a", 20}, person{"b", 30}), cmp.LT)
+	assert.Equal(t, ord.Compare(person{"a", 20}, person{"b", 20}), cmp.EQ)
+	assert.Equal(t, ord.Compare(person{"a", 30}, person{"b", 20}), cmp.GT)
+}
+
+func TestThenBy(t *testing.T) {
+	ord := cmp.ThenBy(
+		cmp.Comparing(personName, cmp.DeriveOrd[string]()),
+		cmp.Comparing(personAge, cmp.Reverse(cmp.DeriveOrd[int]())),
+	)
+	assert.Equal(t, ord.Compare(person{"a", 20}, person{"b", 10}), cmp.LT)
+	assert.Equal(t, ord.Compare(person{"b", 10}, person{"a", 20}), cmp.GT)
+	assert.Equal(t, ord.Compare(person{"a", 20}, person{"a", 10}), cmp.LT)
+	assert.Equal(t, ord.Compare(person{"a", 10}, person{"a", 20}), cmp.GT)
+	assert.Equal(t, ord.Compare(person{"a", 10}, person{"a", 10}), cmp.EQ)
+}
+
+func TestLexicographic(t *testing.T) {
+	ord := cmp.Lexicographic(
+		cmp.Comparing(personAge, cmp.DeriveOrd[int]()),
+		cmp.Comparing(personName, cmp.DeriveOrd[string]()),
+	)
+	assert.Equal(t, ord.Compare(person{"b", 1}, person{"a", 2}), cmp.LT)
+	assert.Equal(t, ord.Compare(person{"b", 1}, person{"a", 1}), cmp.GT)
+	assert.Equal(t, ord.Compare(person{"a", 1}, person{"a", 1}), cmp.EQ)
+	cmptest.AssertOrd(t, ord,
+		arbitrary.Convert(
+			pair.DerivePairArbitrary(arbitrary.String(), arbitrary.Int[int]()),
+			func(p pair.Pair[string, int]) person { return person{p.First, p.Second} },
+			func(p person) pair.Pair[string, int] { return pair.Pair[string, int]{First: p.name, Second: p.age} },
+		),
+		debug.DeriveDebug[person](),
+	)
+
+	empty := cmp.Lexicographic[int]()
+	assert.Equal(t, empty.Compare(1, 2), cmp.EQ)
+}
+
+func TestEqOn(t *testing.T) {
+	eq := cmp.EqOn(personName, cmp.DeriveEq[string]())
+	assert.True(t, eq.Equal(person{"a", 10}, person{"a", 20}))
+	assert.False(t, eq.Equal(person{"a", 10}, person{"b", 10}))
+}
+
+func TestEqAll(t *testing.T) {
+	eq := cmp.EqAll(
+		cmp.EqOn(personName, cmp.DeriveEq[string]()),
+		cmp.EqOn(personAge, cmp.DeriveEq[int]()),
+	)
+	assert.True(t, eq.Equal(person{"a", 10}, person{"a", 10}))
+	assert.False(t, eq.Equal(person{"a", 10}, person{"a", 20}))
+	assert.False(t, eq.Equal(person{"a", 10}, person{"b", 10}))
+
+	empty := cmp.EqAll[int]()
+	assert.True(t, empty.Equal(1, 2))
+}
+
+func TestFromLess(t *testing.T) {
+	ord := cmp.FromLess(func(x, y int) bool { return x < y })
+	assert.Equal(t, ord.Compare(1, 2), cmp.LT)
+	assert.Equal(t, ord.Compare(2, 2), cmp.EQ)
+	assert.Equal(t, ord.Compare(3, 2), cmp.GT)
+	cmptest.AssertOrd(t, ord, arbitrary.Int[int](), debug.DeriveDebug[int]())
+
+	byAge := cmp.FromLess(func(p, q person) bool { return p.age < q.age })
+	assert.Equal(t, byAge.Compare(person{"a", 1}, person{"b", 1}), cmp.EQ)
+}
+
+func TestFromCompareInt(t *testing.T) {
+	ord := cmp.FromCompareInt(strings.Compare)
+	assert.Equal(t, ord.Compare("a", "b"), cmp.LT)
+	assert.Equal(t, ord.Compare("b", "b"), cmp.EQ)
+	assert.Equal(t, ord.Compare("c", "b"), cmp.GT)
+	cmptest.AssertOrd(t, ord, arbitrary.String(), debug.DeriveDebug[string]())
+}
+
+func TestFromKey(t *testing.T) {
+	ord := cmp.FromKey(personName)
+	assert.Equal(t, ord.Compare(person{"a", 2}, person{"b", 1}), cmp.LT)
+	assert.Equal(t, ord.Compare(person{"a", 2}, person{"a", 1}), cmp.EQ)
+	assert.Equal(t, ord.Compare(person{"b", 2}, person{"a", 1}), cmp.GT)
+}
@@ -1,3 +1,29 @@
 package debug
 
-// TODO: type Debug[T any] interface { DebugFmt(T) string }
+import "fmt"
+
+// Debug defines how to format values of type T for debugging.
+type Debug[T any] interface {
+	// DebugFmt returns a human-readable representation of given argument.
+	DebugFmt(T) string
+}
+
+// DefaultDebug is Debug with default implementations.
+type DefaultDebug[T any] struct {
+	DebugFmtImpl func(T) string
+}
+
+func (d *DefaultDebug[T]) DebugFmt(x T) string {
+	return d.DebugFmtImpl(x)
+}
+
+// DeriveDebug derives Debug using `fmt.Sprintf("%#v", x)`.
+func DeriveDebug[T any]() Debug[T] {
+	return derivedDebug[T]{}
+}
+
+type derivedDebug[T any] struct{}
+
+func (derivedDebug[T]) DebugFmt(x T) string {
+	return fmt.Sprintf("%#v", x)
+}
@@ -0,0 +1,23 @@
+package debug_test
+
+import (
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestDeriveDebug(t *testing.T) {
+	assert.Equal(t, debug.DeriveDebug[int]().DebugFmt(123), "123")
+	assert.Equal(t, debug.DeriveDebug[string]().DebugFmt("hoge"), `"hoge"`)
+	assert.Equal(t, debug.DeriveDebug[[]int]().DebugFmt([]int{1, 2}), "[]int{1, 2}")
+}
+
+func TestDefaultDebug(t *testing.T) {
+	var d debug.Debug[int] = &debug.DefaultDebug[int]{
+		DebugFmtImpl: func(x int) string {
+			return "#" + strconv.Itoa(x)
+		},
+	}
+	assert.Equal(t, d.DebugFmt(123), "#123")
+}
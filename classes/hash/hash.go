@@ -0,0 +1,59 @@
+// Package hash provides Hash type class, which makes it possible to use non-comparable types as keys of hash tables.
+package hash
+
+import (
+	"github.com/genkami/dogs/classes/cmp"
+	"hash/maphash"
+)
+
+// Hash is Eq that can also compute hash values.
+// Hash(x) == Hash(y) must hold whenever Equal(x, y) holds.
+type Hash[T any] interface {
+	cmp.Eq[T]
+
+	// Hash returns a hash value of the given argument.
+	// Hash values may differ between different processes.
+	Hash(T) uint64
+}
+
+// DefaultHash is Hash with default implementations.
+type DefaultHash[T any] struct {
+	cmp.Eq[T]
+	HashImpl func(T) uint64
+}
+
+func (h *DefaultHash[T]) Hash(x T) uint64 {
+	return h.HashImpl(x)
+}
+
+// seed is shared among all Hash instances so that hash values are consistent within a process.
+var seed = maphash.MakeSeed()
+
+// DeriveHash derives Hash using standard `==` operator and hash/maphash.
+func DeriveHash[T comparable]() Hash[T] {
+	return derivedHash[T]{}
+}
+
+type derivedHash[T comparable] struct{}
+
+func (derivedHash[T]) Equal(x, y T) bool {
+	return x == y
+}
+
+func (derivedHash[T]) Hash(x T) uint64 {
+	return maphash.Comparable(seed, x)
+}
+
+// Combine mixes a hash value x into another hash value h.
+// It is useful to compute hash values of composite types, e.g.:
+//
+//	Combine(Combine(Empty, h.Hash(x)), h.Hash(y))
+//
+// The result depends on the order of arguments.
+func Combine(h, x uint64) uint64 {
+	// Based on hash_combine in Boost.
+	return h ^ (x + 0x9e3779b97f4a7c15 + (h << 6) + (h >> 2))
+}
+
+// Empty is a hash value of a composite value that has no components.
+const Empty uint64 = 0
@@ -0,0 +1,48 @@
+package hash_test
+
+import (
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/hash"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestDeriveHash(t *testing.T) {
+	h := hash.DeriveHash[string]()
+	assert.True(t, h.Equal("abc", "abc"))
+	assert.False(t, h.Equal("abc", "abd"))
+	assert.Equal(t, h.Hash("abc"), h.Hash("abc"))
+	assert.NotEqual(t, h.Hash("abc"), h.Hash("abd"))
+
+	type key struct {
+		a int
+		b string
+	}
+	hk := hash.DeriveHash[key]()
+	assert.Equal(t, hk.Hash(key{1, "a"}), hk.Hash(key{1, "a"}))
+	assert.NotEqual(t, hk.Hash(key{1, "a"}), hk.Hash(key{1, "b"}))
+}
+
+func TestDefaultHash(t *testing.T) {
+	// Case-insensitive comparison of ASCII letters.
+	lower := func(c byte) byte {
+		if 'A' <= c && c <= 'Z' {
+			return c - 'A' + 'a'
+		}
+		return c
+	}
+	var h hash.Hash[byte] = &hash.DefaultHash[byte]{
+		Eq: &cmp.DefaultEq[byte]{
+			EqualImpl: func(x, y byte) bool { return lower(x) == lower(y) },
+		},
+		HashImpl: func(x byte) uint64 { return uint64(lower(x)) },
+	}
+	assert.True(t, h.Equal('a', 'A'))
+	assert.Equal(t, h.Hash('a'), h.Hash('A'))
+	assert.NotEqual(t, h.Hash('a'), h.Hash('b'))
+}
+
+func TestCombine(t *testing.T) {
+	assert.NotEqual(t, hash.Combine(hash.Empty, 1), hash.Empty)
+	assert.NotEqual(t, hash.Combine(hash.Combine(hash.Empty, 1), 2), hash.Combine(hash.Combine(hash.Empty, 2), 1))
+}
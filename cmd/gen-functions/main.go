@@ -7,6 +7,9 @@
 //     type YourCollection[T] struct { ... }
 //     func FromIterator[T any](it iterator.Iterator[T]) YourCollection[T] { ... }
 //     func (xs YourCollection[T]) Iter() iterator.Iterator[T] { ... }
+//
+// The Collection template also defines `func (xs YourCollection[T]) All() iter.Seq[T]`
+// so that YourCollection can be used in range-over-func loops.
 
 package main
 
@@ -130,6 +133,7 @@ import (
 	"github.com/genkami/dogs/classes/algebra"
 	"github.com/genkami/dogs/classes/cmp"
 	"github.com/genkami/dogs/types/pair"
+	"iter"
 	{{ if ne .IterPrefix "" }}"github.com/genkami/dogs/types/iterator"{{ end }}
 )
 
@@ -139,6 +143,7 @@ var _ = (algebra.Monoid[int])(nil)
 var _ = (cmp.Ord[int])(nil)
 var _ = ({{ .IterPrefix }}Iterator[int])(nil)
 var _ = (*pair.Pair[int, int])(nil)
+var _ = (iter.Seq[int])(nil)
 
 `
 
@@ -148,7 +153,33 @@ var allTemplates = map[string]map[string]string{
 	"Monad":             monadTmpl,
 }
 
+// Methods are prefixed with "." so that they don't conflict with functions with the same name.
 var collectionTmpl = map[string]string{
+	".All": `
+// All returns an iter.Seq that yields every element in xs.
+// It can be used in range-over-func loops, e.g. ` + "`for x := range xs.All()`" + `.
+func (xs {{ .TypeName }}[T]) All() iter.Seq[T] {
+	return {{ .IterPrefix }}ToSeq(xs.Iter())
+}
+`,
+	"Count": `
+// Count returns the number of elements in xs.
+func Count[T {{ .Constraint }}](xs {{ .TypeName }}[T]) int {
+	return {{ .IterPrefix }}Count[T](xs.Iter())
+}
+`,
+	"Any": `
+// Any returns true if and only if at least one element in xs satisfies the given predicate fn.
+func Any[T {{ .Constraint }}](xs {{ .TypeName }}[T], fn func(T) bool) bool {
+	return {{ .IterPrefix }}Any[T](xs.Iter(), fn)
+}
+`,
+	"All": `
+// All returns true if and only if every element in xs satisfies the given predicate fn.
+func All[T {{ .Constraint }}](xs {{ .TypeName }}[T], fn func(T) bool) bool {
+	return {{ .IterPrefix }}All[T](xs.Iter(), fn)
+}
+`,
 	"Find": `
 // Find returns a first element in xs that satisfies the given predicate fn.
 // It returns false as a second return value if no elements are found.
@@ -257,6 +288,51 @@ func FindElemIndex[T {{ .Constraint }}](eq cmp.Eq[T]) func(xs {{ .TypeName }}[T]
 		return {{ .IterPrefix }}FindElemIndex[T](eq)(xs.Iter(), e)
 	}
 }
+`,
+	"Take": `
+// Take returns a collection of the first n elements in xs.
+func Take[T {{ .Constraint }}](xs {{ .TypeName }}[T], n int) {{ .TypeName }}[T] {
+	return FromIterator[T]({{ .IterPrefix }}Take[T](xs.Iter(), n))
+}
+`,
+	"Drop": `
+// Drop returns a collection of elements in xs except for the first n elements.
+func Drop[T {{ .Constraint }}](xs {{ .TypeName }}[T], n int) {{ .TypeName }}[T] {
+	return FromIterator[T]({{ .IterPrefix }}Drop[T](xs.Iter(), n))
+}
+`,
+	"TakeWhile": `
+// TakeWhile returns a collection of the longest prefix of xs whose elements satisfy the given predicate fn.
+func TakeWhile[T {{ .Constraint }}](xs {{ .TypeName }}[T], fn func(T) bool) {{ .TypeName }}[T] {
+	return FromIterator[T]({{ .IterPrefix }}TakeWhile[T](xs.Iter(), fn))
+}
+`,
+	"DropWhile": `
+// DropWhile returns a collection of elements in xs except for the longest prefix whose elements satisfy the given predicate fn.
+func DropWhile[T {{ .Constraint }}](xs {{ .TypeName }}[T], fn func(T) bool) {{ .TypeName }}[T] {
+	return FromIterator[T]({{ .IterPrefix }}DropWhile[T](xs.Iter(), fn))
+}
+`,
+	"StepBy": `
+// StepBy returns a collection of the first element in xs and then every n-th element after that.
+// It panics if n is not positive.
+func StepBy[T {{ .Constraint }}](xs {{ .TypeName }}[T], n int) {{ .TypeName }}[T] {
+	return FromIterator[T]({{ .IterPrefix }}StepBy[T](xs.Iter(), n))
+}
+`,
+	"Nth": `
+// Nth returns the n-th element (0-indexed) in xs.
+// It returns false as a second return value if xs has n or fewer elements, or if n is negative.
+func Nth[T {{ .Constraint }}](xs {{ .TypeName }}[T], n int) (T, bool) {
+	return {{ .IterPrefix }}Nth[T](xs.Iter(), n)
+}
+`,
+	"Last": `
+// Last returns the last element in xs.
+// It returns false as a second return value if xs is empty.
+func Last[T {{ .Constraint }}](xs {{ .TypeName }}[T]) (T, bool) {
+	return {{ .IterPrefix }}Last[T](xs.Iter())
+}
 `,
 	"Zip": `
 // Zip combines two collections into one that contains pairs of corresponding elements.
@@ -5,6 +5,7 @@ import (
 	"github.com/genkami/dogs/types/iterator"
 	"github.com/genkami/dogs/types/slice"
 	"github.com/stretchr/testify/assert"
+	"slices"
 	"testing"
 )
 
@@ -61,3 +62,16 @@ func TestChannel_Iter(t *testing.T) {
 		assert.False(t, ok)
 	})
 }
+
+func TestChannel_All(t *testing.T) {
+	ch := channel.FromIterator[int](slice.Slice[int]{1, 2, 3}.Iter())
+	assert.Equal(t, slices.Collect(ch.All()), []int{1, 2, 3})
+}
+
+func TestSlicing(t *testing.T) {
+	ch := channel.FromIterator[int](slice.Slice[int]{1, 2, 3, 4, 5}.Iter())
+	assert.Equal(t, slices.Collect(channel.Drop(ch, 3).All()), []int{4, 5})
+
+	ch = channel.FromIterator[int](slice.Slice[int]{1, 2, 3, 4, 5}.Iter())
+	assert.Equal(t, channel.Count(channel.TakeWhile(ch, func(x int) bool { return x < 3 })), 2)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/genkami/dogs/classes/cmp"
 	"github.com/genkami/dogs/types/iterator"
 	"github.com/genkami/dogs/types/pair"
+	"iter"
 )
 
 // Some packages are unused depending on -include CLI option.
@@ -15,6 +16,28 @@ var _ = (algebra.Monoid[int])(nil)
 var _ = (cmp.Ord[int])(nil)
 var _ = (iterator.Iterator[int])(nil)
 var _ = (*pair.Pair[int, int])(nil)
+var _ = (iter.Seq[int])(nil)
+
+// All returns an iter.Seq that yields every element in xs.
+// It can be used in range-over-func loops, e.g. `for x := range xs.All()`.
+func (xs Chan[T]) All() iter.Seq[T] {
+	return iterator.ToSeq(xs.Iter())
+}
+
+// All returns true if and only if every element in xs satisfies the given predicate fn.
+func All[T any](xs Chan[T], fn func(T) bool) bool {
+	return iterator.All[T](xs.Iter(), fn)
+}
+
+// Any returns true if and only if at least one element in xs satisfies the given predicate fn.
+func Any[T any](xs Chan[T], fn func(T) bool) bool {
+	return iterator.Any[T](xs.Iter(), fn)
+}
+
+// Count returns the number of elements in xs.
+func Count[T any](xs Chan[T]) int {
+	return iterator.Count[T](xs.Iter())
+}
 
 // Filter returns a collection that only returns elements that satisfies given predicate.
 func Filter[T any](xs Chan[T], fn func(T) bool) Chan[T] {
@@ -1,7 +1,103 @@
 package funcs
 
-// TODO: Identity[T any](x T) T
-// TODO: Const[T, U any](c T) func(U) T
-// TODO: Compose[T, U, V any](f func(T) U, g func(U) V) func(T) V
-// TODO: Apply[T, U any](x T) func(func(T) U) U
-// TODO: On[T, U, V any](f func(T, T) U, g func(V) T) func(V, V) U
+import "github.com/genkami/dogs/types/pair"
+
+// Identity returns x as is.
+func Identity[T any](x T) T {
+	return x
+}
+
+// Const returns a function that ignores its argument and always returns c.
+func Const[T, U any](c T) func(U) T {
+	return func(_ U) T {
+		return c
+	}
+}
+
+// Compose returns a function that applies f and then g.
+func Compose[T, U, V any](f func(T) U, g func(U) V) func(T) V {
+	return func(x T) V {
+		return g(f(x))
+	}
+}
+
+// Pipe returns a function that applies each function in fns from left to right.
+// It returns Identity if fns is empty.
+func Pipe[T any](fns ...func(T) T) func(T) T {
+	return func(x T) T {
+		for _, fn := range fns {
+			x = fn(x)
+		}
+		return x
+	}
+}
+
+// Apply returns a function that applies its argument to x.
+func Apply[T, U any](x T) func(func(T) U) U {
+	return func(fn func(T) U) U {
+		return fn(x)
+	}
+}
+
+// On returns a function that applies g to each argument and then combines them with f.
+// For example, On(ord.Lt, key) compares two values by their keys.
+func On[T, U, V any](f func(T, T) U, g func(V) T) func(V, V) U {
+	return func(x, y V) U {
+		return f(g(x), g(y))
+	}
+}
+
+// Flip returns a function that takes its arguments in the reverse order.
+func Flip[T, U, V any](f func(T, U) V) func(U, T) V {
+	return func(y U, x T) V {
+		return f(x, y)
+	}
+}
+
+// Curry2 converts a function that takes two arguments into one that takes arguments one by one.
+func Curry2[T, U, V any](f func(T, U) V) func(T) func(U) V {
+	return func(x T) func(U) V {
+		return func(y U) V {
+			return f(x, y)
+		}
+	}
+}
+
+// Uncurry2 is the inverse of Curry2.
+func Uncurry2[T, U, V any](f func(T) func(U) V) func(T, U) V {
+	return func(x T, y U) V {
+		return f(x)(y)
+	}
+}
+
+// Curry3 converts a function that takes three arguments into one that takes arguments one by one.
+func Curry3[T, U, V, W any](f func(T, U, V) W) func(T) func(U) func(V) W {
+	return func(x T) func(U) func(V) W {
+		return func(y U) func(V) W {
+			return func(z V) W {
+				return f(x, y, z)
+			}
+		}
+	}
+}
+
+// Uncurry3 is the inverse of Curry3.
+func Uncurry3[T, U, V, W any](f func(T) func(U) func(V) W) func(T, U, V) W {
+	return func(x T, y U, z V) W {
+		return f(x)(y)(z)
+	}
+}
+
+// Tupled converts a function that takes two arguments into one that takes a Pair.
+func Tupled[T, U, V any](f func(T, U) V) func(pair.Pair[T, U]) V {
+	return func(p pair.Pair[T, U]) V {
+		return f(p.First, p.Second)
+	}
+}
+
+// Untupled is the inverse of Tupled.
+func Untupled[T, U, V any](f func(pair.Pair[T, U]) V) func(T, U) V {
+	return func(x T, y U) V {
+		return f(pair.Pair[T, U]{First: x, Second: y})
+	}
+}
@@ -0,0 +1,73 @@
+package funcs_test
+
+import (
+	"github.com/genkami/dogs/types/funcs"
+	"github.com/genkami/dogs/types/pair"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestIdentity(t *testing.T) {
+	assert.Equal(t, funcs.Identity(123), 123)
+	assert.Equal(t, funcs.Identity("hoge"), "hoge")
+}
+
+func TestConst(t *testing.T) {
+	fn := funcs.Const[int, string](123)
+	assert.Equal(t, fn("hoge"), 123)
+	assert.Equal(t, fn("fuga"), 123)
+}
+
+func TestCompose(t *testing.T) {
+	fn := funcs.Compose(strconv.Itoa, func(s string) int { return len(s) })
+	assert.Equal(t, fn(1), 1)
+	assert.Equal(t, fn(123), 3)
+}
+
+func TestPipe(t *testing.T) {
+	inc := func(x int) int { return x + 1 }
+	double := func(x int) int { return x * 2 }
+
+	assert.Equal(t, funcs.Pipe[int]()(3), 3)
+	assert.Equal(t, funcs.Pipe(inc)(3), 4)
+	assert.Equal(t, funcs.Pipe(inc, double)(3), 8)
+	assert.Equal(t, funcs.Pipe(double, inc)(3), 7)
+}
+
+func TestApply(t *testing.T) {
+	apply := funcs.Apply[int, string](123)
+	assert.Equal(t, apply(strconv.Itoa), "123")
+}
+
+func TestOn(t *testing.T) {
+	sameLength := funcs.On(func(x, y int) bool { return x == y }, func(s string) int { return len(s) })
+	assert.True(t, sameLength("abc", "def"))
+	assert.False(t, sameLength("abc", "de"))
+}
+
+func TestFlip(t *testing.T) {
+	sub := func(x, y int) int { return x - y }
+	assert.Equal(t, funcs.Flip(sub)(1, 3), 2)
+}
+
+func TestCurry2(t *testing.T) {
+	sub := func(x, y int) int { return x - y }
+	curried := funcs.Curry2(sub)
+	assert.Equal(t, curried(3)(1), 2)
+	assert.Equal(t, funcs.Uncurry2(curried)(3, 1), 2)
+}
+
+func TestCurry3(t *testing.T) {
+	fn := func(x, y, z int) int { return x*100 + y*10 + z }
+	curried := funcs.Curry3(fn)
+	assert.Equal(t, curried(1)(2)(3), 123)
+	assert.Equal(t, funcs.Uncurry3(curried)(1, 2, 3), 123)
+}
+
+func TestTupled(t *testing.T) {
+	sub := func(x, y int) int { return x - y }
+	tupled := funcs.Tupled(sub)
+	assert.Equal(t, tupled(pair.Pair[int, int]{First: 3, Second: 1}), 2)
+	assert.Equal(t, funcs.Untupled(tupled)(3, 1), 2)
+}
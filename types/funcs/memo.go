@@ -0,0 +1,112 @@
+package funcs
+
+import (
+	"container/list"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/types/pair"
+	"sync"
+)
+
+// Memoize returns a function that caches results of fn.
+// The cache grows without bound, and the returned function is not safe for concurrent use.
+// Use MemoizeSync or MemoizeLRU instead if you need them.
+//
+// A recursive function can be memoized by referring to the memoized version from its body:
+//
+//	var fib func(int) int
+//	fib = Memoize(func(n int) int {
+//		if n < 2 {
+//			return n
+//		}
+//		return fib(n-1) + fib(n-2)
+//	})
+func Memoize[K comparable, V any](fn func(K) V) func(K) V {
+	cache := map[K]V{}
+	return func(k K) V {
+		if v, ok := cache[k]; ok {
+			return v
+		}
+		v := fn(k)
+		cache[k] = v
+		return v
+	}
+}
+
+// MemoizeSync is the same as Memoize except that the returned function is safe for concurrent use.
+// fn is called without holding any lock, so fn may be called more than once for the same key
+// if the key is requested concurrently.
+func MemoizeSync[K comparable, V any](fn func(K) V) func(K) V {
+	var mu sync.Mutex
+	cache := map[K]V{}
+	return func(k K) V {
+		mu.Lock()
+		v, ok := cache[k]
+		mu.Unlock()
+		if ok {
+			return v
+		}
+		v = fn(k)
+		mu.Lock()
+		cache[k] = v
+		mu.Unlock()
+		return v
+	}
+}
+
+// MemoizeLRU returns a function that caches at most size results of fn,
+// evicting the least recently used one when the cache is full.
+// The returned function is safe for concurrent use in the same manner as MemoizeSync.
+// It panics if size is not positive.
+func MemoizeLRU[K comparable, V any](size int, fn func(K) V) func(K) V {
+	if size <= 0 {
+		panic("funcs.MemoizeLRU: size must be positive")
+	}
+	var mu sync.Mutex
+	// Front is the most recently used one.
+	order := list.New()
+	cache := map[K]*list.Element{}
+	return func(k K) V {
+		mu.Lock()
+		if e, ok := cache[k]; ok {
+			order.MoveToFront(e)
+			v := e.Value.(pair.Pair[K, V]).Second
+			mu.Unlock()
+			return v
+		}
+		mu.Unlock()
+
+		v := fn(k)
+
+		mu.Lock()
+		defer mu.Unlock()
+		if e, ok := cache[k]; ok {
+			// Someone else has already computed it.
+			order.MoveToFront(e)
+			return v
+		}
+		cache[k] = order.PushFront(pair.Pair[K, V]{First: k, Second: v})
+		if size < order.Len() {
+			oldest := order.Remove(order.Back()).(pair.Pair[K, V])
+			delete(cache, oldest.First)
+		}
+		return v
+	}
+}
+
+// MemoizeWithEq is the same as Memoize except that it can be used with non-comparable keys.
+// Keys are compared using eq, and hash must return the same value for keys that are equal in the sense of eq.
+// The returned function is not safe for concurrent use.
+func MemoizeWithEq[K, V any](eq cmp.Eq[K], hash func(K) uint64, fn func(K) V) func(K) V {
+	buckets := map[uint64][]pair.Pair[K, V]{}
+	return func(k K) V {
+		h := hash(k)
+		for _, p := range buckets[h] {
+			if eq.Equal(p.First, k) {
+				return p.Second
+			}
+		}
+		v := fn(k)
+		buckets[h] = append(buckets[h], pair.Pair[K, V]{First: k, Second: v})
+		return v
+	}
+}
@@ -0,0 +1,120 @@
+package funcs_test
+
+import (
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/types/funcs"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+)
+
+// counting returns a function that doubles its argument and counts how many times it is called for each argument.
+func counting() (func(int) int, map[int]int) {
+	calls := map[int]int{}
+	return func(x int) int {
+		calls[x]++
+		return x * 2
+	}, calls
+}
+
+func TestMemoize(t *testing.T) {
+	fn, calls := counting()
+	memo := funcs.Memoize(fn)
+
+	assert.Equal(t, memo(1), 2)
+	assert.Equal(t, memo(2), 4)
+	assert.Equal(t, memo(1), 2)
+	assert.Equal(t, calls, map[int]int{1: 1, 2: 1})
+
+	t.Run("recursive", func(t *testing.T) {
+		numCalled := 0
+		var fib func(int) int
+		fib = funcs.Memoize(func(n int) int {
+			numCalled++
+			if n < 2 {
+				return n
+			}
+			return fib(n-1) + fib(n-2)
+		})
+		assert.Equal(t, fib(50), 12586269025)
+		assert.Equal(t, numCalled, 51)
+	})
+}
+
+func TestMemoizeSync(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[int]int{}
+	memo := funcs.MemoizeSync(func(x int) int {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[x]++
+		return x * 2
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for x := 0; x < 100; x++ {
+				assert.Equal(t, memo(x), x*2)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, len(calls), 100)
+	for x := 0; x < 100; x++ {
+		assert.Equal(t, memo(x), x*2)
+	}
+	assert.Equal(t, len(calls), 100)
+}
+
+func TestMemoizeLRU(t *testing.T) {
+	fn, calls := counting()
+	memo := funcs.MemoizeLRU(2, fn)
+
+	assert.Equal(t, memo(1), 2)
+	assert.Equal(t, memo(2), 4)
+	assert.Equal(t, memo(1), 2)
+	assert.Equal(t, calls, map[int]int{1: 1, 2: 1})
+
+	// 2 is the least recently used one.
+	assert.Equal(t, memo(3), 6)
+	assert.Equal(t, memo(1), 2)
+	assert.Equal(t, calls, map[int]int{1: 1, 2: 1, 3: 1})
+	assert.Equal(t, memo(2), 4)
+	assert.Equal(t, calls, map[int]int{1: 1, 2: 2, 3: 1})
+
+	assert.Panics(t, func() { funcs.MemoizeLRU(0, fn) })
+}
+
+func TestMemoizeWithEq(t *testing.T) {
+	eq := &cmp.DefaultEq[[]int]{
+		EqualImpl: func(xs, ys []int) bool {
+			if len(xs) != len(ys) {
+				return false
+			}
+			for i := range xs {
+				if xs[i] != ys[i] {
+					return false
+				}
+			}
+			return true
+		},
+	}
+	// A poor hash function to test collisions.
+	hash := func(xs []int) uint64 { return uint64(len(xs)) }
+
+	numCalled := 0
+	memo := funcs.MemoizeWithEq(eq, hash, func(xs []int) int {
+		numCalled++
+		return len(xs)
+	})
+
+	assert.Equal(t, memo([]int{1, 2}), 2)
+	assert.Equal(t, memo([]int{3, 4}), 2)
+	assert.Equal(t, memo([]int{1, 2}), 2)
+	assert.Equal(t, memo([]int{}), 0)
+	assert.Equal(t, numCalled, 3)
+}
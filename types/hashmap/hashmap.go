@@ -0,0 +1,149 @@
+// Package hashmap provides a hash table whose keys are compared by hash.Hash instead of `==`.
+package hashmap
+
+import (
+	"github.com/genkami/dogs/classes/hash"
+	"github.com/genkami/dogs/types/iterator"
+	"github.com/genkami/dogs/types/pair"
+)
+
+// HashMap is a map whose keys are compared by hash.Hash.
+// Unlike built-in maps, K doesn't need to be comparable.
+// The zero value is not ready to use. Use New to create a HashMap.
+type HashMap[K, V any] struct {
+	h       hash.Hash[K]
+	buckets map[uint64][]pair.Pair[K, V]
+	len     int
+}
+
+// New creates an empty HashMap whose keys are compared by h.
+func New[K, V any](h hash.Hash[K]) *HashMap[K, V] {
+	return &HashMap[K, V]{
+		h:       h,
+		buckets: map[uint64][]pair.Pair[K, V]{},
+	}
+}
+
+// FromIterator builds a HashMap from given Iterator of key-value pairs.
+// If the same key appears more than once, the last one wins.
+func FromIterator[K, V any](h hash.Hash[K]) func(it iterator.Iterator[pair.Pair[K, V]]) *HashMap[K, V] {
+	return func(it iterator.Iterator[pair.Pair[K, V]]) *HashMap[K, V] {
+		m := New[K, V](h)
+		iterator.ForEach(it, func(p pair.Pair[K, V]) {
+			Insert(m, p.First, p.Second)
+		})
+		return m
+	}
+}
+
+// find returns the index of k in the bucket, or -1 if not found.
+func (m *HashMap[K, V]) find(bucket []pair.Pair[K, V], k K) int {
+	for i, p := range bucket {
+		if m.h.Equal(p.First, k) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Get returns a value that corresponds to k.
+// It returns false as a second return value if m doesn't have k.
+func Get[K, V any](m *HashMap[K, V], k K) (V, bool) {
+	bucket := m.buckets[m.h.Hash(k)]
+	if i := m.find(bucket, k); i >= 0 {
+		return bucket[i].Second, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Has returns true if and only if m has k.
+func Has[K, V any](m *HashMap[K, V], k K) bool {
+	_, ok := Get(m, k)
+	return ok
+}
+
+// Insert inserts v into m with key k.
+// If m already has k, its value is replaced with v.
+func Insert[K, V any](m *HashMap[K, V], k K, v V) {
+	hk := m.h.Hash(k)
+	bucket := m.buckets[hk]
+	if i := m.find(bucket, k); i >= 0 {
+		bucket[i].Second = v
+		return
+	}
+	m.buckets[hk] = append(bucket, pair.Pair[K, V]{First: k, Second: v})
+	m.len++
+}
+
+// Delete removes k and its value from m.
+// It returns false if and only if m doesn't have k.
+func Delete[K, V any](m *HashMap[K, V], k K) bool {
+	hk := m.h.Hash(k)
+	bucket := m.buckets[hk]
+	i := m.find(bucket, k)
+	if i < 0 {
+		return false
+	}
+	last := len(bucket) - 1
+	bucket[i] = bucket[last]
+	bucket[last] = pair.Pair[K, V]{}
+	if last == 0 {
+		delete(m.buckets, hk)
+	} else {
+		m.buckets[hk] = bucket[:last]
+	}
+	m.len--
+	return true
+}
+
+// Len returns the number of entries in m.
+func Len[K, V any](m *HashMap[K, V]) int {
+	return m.len
+}
+
+// Iter returns an Iterator that iterates over key-value pairs in m.
+// The order of elements is unspecified.
+func (m *HashMap[K, V]) Iter() iterator.Iterator[pair.Pair[K, V]] {
+	kvs := KeyValues(m)
+	return iterator.Unfold[int, pair.Pair[K, V]](0, func(i int) (int, pair.Pair[K, V], bool) {
+		if len(kvs) <= i {
+			return 0, pair.Pair[K, V]{}, false
+		}
+		return i + 1, kvs[i], true
+	})
+}
+
+// KeyValues returns a slice of key-value pairs in m.
+// The order of elements is unspecified.
+func KeyValues[K, V any](m *HashMap[K, V]) []pair.Pair[K, V] {
+	kvs := make([]pair.Pair[K, V], 0, m.len)
+	for _, bucket := range m.buckets {
+		kvs = append(kvs, bucket...)
+	}
+	return kvs
+}
+
+// Keys returns a slice of keys in m.
+// The order of elements is unspecified.
+func Keys[K, V any](m *HashMap[K, V]) []K {
+	keys := make([]K, 0, m.len)
+	for _, bucket := range m.buckets {
+		for _, p := range bucket {
+			keys = append(keys, p.First)
+		}
+	}
+	return keys
+}
+
+// Values returns a slice of values in m.
+// The order of elements is unspecified.
+func Values[K, V any](m *HashMap[K, V]) []V {
+	values := make([]V, 0, m.len)
+	for _, bucket := range m.buckets {
+		for _, p := range bucket {
+			values = append(values, p.Second)
+		}
+	}
+	return values
+}
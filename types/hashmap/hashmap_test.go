@@ -0,0 +1,113 @@
+package hashmap_test
+
+import (
+	"github.com/genkami/dogs/classes/hash"
+	"github.com/genkami/dogs/types/hashmap"
+	"github.com/genkami/dogs/types/iterator"
+	"github.com/genkami/dogs/types/pair"
+	"github.com/genkami/dogs/types/slice"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"testing"
+)
+
+type Key = slice.Slice[int]
+
+var hashKey = slice.DeriveHash(hash.DeriveHash[int]())
+
+func TestInsertAndGet(t *testing.T) {
+	m := hashmap.New[Key, string](hashKey)
+	hashmap.Insert(m, Key{1, 2}, "a")
+	hashmap.Insert(m, Key{3}, "b")
+	hashmap.Insert(m, Key{}, "c")
+	assert.Equal(t, hashmap.Len(m), 3)
+
+	v, ok := hashmap.Get(m, Key{1, 2})
+	assert.True(t, ok)
+	assert.Equal(t, v, "a")
+	v, ok = hashmap.Get(m, Key{})
+	assert.True(t, ok)
+	assert.Equal(t, v, "c")
+	_, ok = hashmap.Get(m, Key{2, 1})
+	assert.False(t, ok)
+
+	hashmap.Insert(m, Key{1, 2}, "d")
+	assert.Equal(t, hashmap.Len(m), 3)
+	v, _ = hashmap.Get(m, Key{1, 2})
+	assert.Equal(t, v, "d")
+}
+
+func TestHas(t *testing.T) {
+	m := hashmap.New[Key, string](hashKey)
+	hashmap.Insert(m, Key{1, 2}, "a")
+	assert.True(t, hashmap.Has(m, Key{1, 2}))
+	assert.False(t, hashmap.Has(m, Key{1}))
+}
+
+func TestDelete(t *testing.T) {
+	m := hashmap.New[Key, string](hashKey)
+	hashmap.Insert(m, Key{1, 2}, "a")
+	hashmap.Insert(m, Key{3}, "b")
+	assert.True(t, hashmap.Delete(m, Key{1, 2}))
+	assert.False(t, hashmap.Delete(m, Key{1, 2}))
+	assert.False(t, hashmap.Has(m, Key{1, 2}))
+	assert.True(t, hashmap.Has(m, Key{3}))
+	assert.Equal(t, hashmap.Len(m), 1)
+}
+
+func TestCollision(t *testing.T) {
+	// Every key has the same hash value.
+	h := &hash.DefaultHash[int]{
+		Eq:       hash.DeriveHash[int](),
+		HashImpl: func(int) uint64 { return 0 },
+	}
+	m := hashmap.New[int, string](h)
+	hashmap.Insert(m, 1, "a")
+	hashmap.Insert(m, 2, "b")
+	hashmap.Insert(m, 3, "c")
+	assert.Equal(t, hashmap.Len(m), 3)
+	assert.True(t, hashmap.Delete(m, 1))
+	v, ok := hashmap.Get(m, 3)
+	assert.True(t, ok)
+	assert.Equal(t, v, "c")
+	assert.False(t, hashmap.Has(m, 1))
+	assert.Equal(t, hashmap.Len(m), 2)
+}
+
+func TestFromIterator(t *testing.T) {
+	type P = pair.Pair[Key, int]
+	m := hashmap.FromIterator[Key, int](hashKey)(slice.Slice[P]{
+		{First: Key{1}, Second: 1},
+		{First: Key{2}, Second: 2},
+		{First: Key{1}, Second: 3},
+	}.Iter())
+	assert.Equal(t, hashmap.Len(m), 2)
+	v, _ := hashmap.Get(m, Key{1})
+	assert.Equal(t, v, 3)
+}
+
+func TestHashMap_Iter(t *testing.T) {
+	m := hashmap.New[Key, int](hashKey)
+	hashmap.Insert(m, Key{1}, 1)
+	hashmap.Insert(m, Key{2, 2}, 2)
+	values := iterator.Fold(0, m.Iter(), func(acc int, p pair.Pair[Key, int]) int {
+		return acc + len(p.First)*p.Second
+	})
+	assert.Equal(t, values, 5)
+}
+
+func TestKeysAndValues(t *testing.T) {
+	m := hashmap.New[Key, int](hashKey)
+	hashmap.Insert(m, Key{1}, 1)
+	hashmap.Insert(m, Key{2, 2}, 2)
+
+	keys := hashmap.Keys(m)
+	sort.Slice(keys, func(i, j int) bool { return len(keys[i]) < len(keys[j]) })
+	assert.Equal(t, keys, []Key{{1}, {2, 2}})
+
+	values := hashmap.Values(m)
+	sort.Ints(values)
+	assert.Equal(t, values, []int{1, 2})
+
+	assert.Equal(t, len(hashmap.KeyValues(m)), 2)
+}
@@ -0,0 +1,89 @@
+// Package hashset provides a set whose elements are compared by hash.Hash instead of `==`.
+package hashset
+
+import (
+	"github.com/genkami/dogs/classes/hash"
+	"github.com/genkami/dogs/types/hashmap"
+	"github.com/genkami/dogs/types/iterator"
+	"github.com/genkami/dogs/types/slice"
+)
+
+// HashSet is a set whose elements are compared by hash.Hash.
+// Unlike set.Set, T doesn't need to be comparable.
+// The zero value is not ready to use. Use New to create a HashSet.
+type HashSet[T any] struct {
+	m *hashmap.HashMap[T, struct{}]
+}
+
+//go:generate go run ../../cmd/gen-functions -template Collection -pkg hashset -name *HashSet -exclude Filter,Map -out zz_generated.collection.go
+//go:generate go fmt .
+
+// New creates a new HashSet whose elements are compared by h.
+func New[T any](h hash.Hash[T], elems ...T) *HashSet[T] {
+	s := &HashSet[T]{
+		m: hashmap.New[T, struct{}](h),
+	}
+	for _, e := range elems {
+		Add(s, e)
+	}
+	return s
+}
+
+// FromIterator returns a HashSet from given Iterator.
+func FromIterator[T any](h hash.Hash[T]) func(it iterator.Iterator[T]) *HashSet[T] {
+	return func(it iterator.Iterator[T]) *HashSet[T] {
+		s := New(h)
+		iterator.ForEach(it, func(e T) {
+			Add(s, e)
+		})
+		return s
+	}
+}
+
+// Has returns true if and only if s has e.
+func Has[T any](s *HashSet[T], e T) bool {
+	return hashmap.Has(s.m, e)
+}
+
+// Len returns the number of elements in s.
+func Len[T any](s *HashSet[T]) int {
+	return hashmap.Len(s.m)
+}
+
+// Subset returns true if and only if s is a subset of t.
+func Subset[T any](s, t *HashSet[T]) bool {
+	for _, e := range hashmap.Keys(s.m) {
+		if !Has(t, e) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if and only if the two sets s and t have exactly the same elements.
+func Equal[T any](s, t *HashSet[T]) bool {
+	return Len(s) == Len(t) && Subset(s, t)
+}
+
+// Add adds an element e to set s.
+func Add[T any](s *HashSet[T], e T) {
+	hashmap.Insert(s.m, e, struct{}{})
+}
+
+// Remove removes an element e from set s.
+// If returns false if and only if s doesn't have e.
+func Remove[T any](s *HashSet[T], e T) bool {
+	return hashmap.Delete(s.m, e)
+}
+
+// Elems returns a slice of elements in s.
+// The order of elements is unspecified.
+func Elems[T any](s *HashSet[T]) []T {
+	return hashmap.Keys(s.m)
+}
+
+// Iter returns an Iterator that iterates over s.
+// The order of elements is unspecified.
+func (s *HashSet[T]) Iter() iterator.Iterator[T] {
+	return slice.Slice[T](Elems(s)).Iter()
+}
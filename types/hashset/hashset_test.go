@@ -0,0 +1,61 @@
+package hashset_test
+
+import (
+	"github.com/genkami/dogs/classes/hash"
+	"github.com/genkami/dogs/types/hashset"
+	"github.com/genkami/dogs/types/list"
+	"github.com/genkami/dogs/types/slice"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var hashList = list.DeriveHash(hash.DeriveHash[int]())
+
+func TestNew(t *testing.T) {
+	s := hashset.New(hashList, list.New(1, 2), list.New(3), list.New(1, 2))
+	assert.Equal(t, hashset.Len(s), 2)
+	assert.True(t, hashset.Has(s, list.New(1, 2)))
+	assert.True(t, hashset.Has(s, list.New(3)))
+	assert.False(t, hashset.Has(s, list.New(2, 1)))
+	assert.False(t, hashset.Has(s, nil))
+}
+
+func TestAddAndRemove(t *testing.T) {
+	s := hashset.New(hashList)
+	hashset.Add(s, list.New(1))
+	hashset.Add(s, nil)
+	assert.Equal(t, hashset.Len(s), 2)
+	assert.True(t, hashset.Has(s, nil))
+
+	assert.True(t, hashset.Remove(s, list.New(1)))
+	assert.False(t, hashset.Remove(s, list.New(1)))
+	assert.Equal(t, hashset.Len(s), 1)
+}
+
+func TestSubset(t *testing.T) {
+	h := hash.DeriveHash[int]()
+	assert.True(t, hashset.Subset(hashset.New(h), hashset.New(h, 1)))
+	assert.True(t, hashset.Subset(hashset.New(h, 1), hashset.New(h, 1, 2)))
+	assert.False(t, hashset.Subset(hashset.New(h, 1, 3), hashset.New(h, 1, 2)))
+}
+
+func TestEqual(t *testing.T) {
+	h := hash.DeriveHash[int]()
+	assert.True(t, hashset.Equal(hashset.New(h, 1, 2), hashset.New(h, 2, 1)))
+	assert.False(t, hashset.Equal(hashset.New(h, 1, 2), hashset.New(h, 1)))
+	assert.False(t, hashset.Equal(hashset.New(h, 1), hashset.New(h, 1, 2)))
+}
+
+func TestFromIterator(t *testing.T) {
+	hashSlice := slice.DeriveHash(hash.DeriveHash[int]())
+	s := hashset.FromIterator(hashSlice)(slice.Slice[slice.Slice[int]]{{1}, {1, 2}, {1}}.Iter())
+	assert.Equal(t, hashset.Len(s), 2)
+	assert.True(t, hashset.Has(s, slice.Slice[int]{1, 2}))
+}
+
+func TestHashSet_Iter(t *testing.T) {
+	s := hashset.New(hash.DeriveHash[int](), 1, 2, 3)
+	sum := hashset.Fold(0, s, func(acc, x int) int { return acc + x })
+	assert.Equal(t, sum, 6)
+	assert.Equal(t, len(hashset.Elems(s)), 3)
+}
@@ -0,0 +1,108 @@
+// Code generated by gen-functions; DO NOT EDIT.
+
+package hashset
+
+import (
+	"github.com/genkami/dogs/classes/algebra"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/types/iterator"
+	"github.com/genkami/dogs/types/pair"
+	"iter"
+)
+
+// Some packages are unused depending on -include CLI option.
+// This prevents compile error when corresponding functions are not defined.
+var _ = (algebra.Monoid[int])(nil)
+var _ = (cmp.Ord[int])(nil)
+var _ = (iterator.Iterator[int])(nil)
+var _ = (*pair.Pair[int, int])(nil)
+var _ = (iter.Seq[int])(nil)
+
+// All returns an iter.Seq that yields every element in xs.
+// It can be used in range-over-func loops, e.g. `for x := range xs.All()`.
+func (xs *HashSet[T]) All() iter.Seq[T] {
+	return iterator.ToSeq(xs.Iter())
+}
+
+// All returns true if and only if every element in xs satisfies the given predicate fn.
+func All[T any](xs *HashSet[T], fn func(T) bool) bool {
+	return iterator.All[T](xs.Iter(), fn)
+}
+
+// Any returns true if and only if at least one element in xs satisfies the given predicate fn.
+func Any[T any](xs *HashSet[T], fn func(T) bool) bool {
+	return iterator.Any[T](xs.Iter(), fn)
+}
+
+// Count returns the number of elements in xs.
+func Count[T any](xs *HashSet[T]) int {
+	return iterator.Count[T](xs.Iter())
+}
+
+// Find returns a first element in xs that satisfies the given predicate fn.
+// It returns false as a second return value if no elements are found.
+func Find[T any](xs *HashSet[T], fn func(T) bool) (T, bool) {
+	return iterator.Find[T](xs.Iter(), fn)
+}
+
+// FindElem returns a first element in xs that equals to e in the sense of given Eq.
+// It returns false as a second return value if no elements are found.
+func FindElem[T any](eq cmp.Eq[T]) func(xs *HashSet[T], e T) (T, bool) {
+	return func(xs *HashSet[T], e T) (T, bool) {
+		return iterator.FindElem[T](eq)(xs.Iter(), e)
+	}
+}
+
+// Fold accumulates every element in a collection by applying fn.
+func Fold[T any, U any](init T, xs *HashSet[U], fn func(T, U) T) T {
+	return iterator.Fold[T, U](init, xs.Iter(), fn)
+}
+
+// ForEach applies fn to each element in xs.
+func ForEach[T any](xs *HashSet[T], fn func(T)) {
+	iterator.ForEach[T](xs.Iter(), fn)
+}
+
+// Max returns the largest element with respect to the given Ord.
+// It returns <zero value>, false if the collection is empty.
+func Max[T any](ord cmp.Ord[T]) func(xs *HashSet[T]) (T, bool) {
+	return func(xs *HashSet[T]) (T, bool) {
+		return iterator.Max(ord)(xs.Iter())
+	}
+}
+
+// MaxBy returns the smallest element with respect to the given function.
+// It returns <zero value>, false if the collection is empty.
+func MaxBy[T any](xs *HashSet[T], less func(T, T) bool) (T, bool) {
+	return iterator.MaxBy(xs.Iter(), less)
+}
+
+// Min returns the smallest element with respect to the given Ord.
+// It returns <zero value>, false if the collection is empty.
+func Min[T any](ord cmp.Ord[T]) func(xs *HashSet[T]) (T, bool) {
+	return func(xs *HashSet[T]) (T, bool) {
+		return iterator.Min(ord)(xs.Iter())
+	}
+}
+
+// MinBy returns the smallest element with respect to the given function.
+// It returns <zero value>, false if the collection is empty.
+func MinBy[T any](xs *HashSet[T], less func(T, T) bool) (T, bool) {
+	return iterator.MinBy(xs.Iter(), less)
+}
+
+// Sum sums up all values in xs.
+// It returns m.Empty() when xs is empty.
+func Sum[T any](m algebra.Monoid[T]) func(xs *HashSet[T]) T {
+	return func(xs *HashSet[T]) T {
+		var s algebra.Semigroup[T] = m
+		return SumWithInit[T](s)(m.Empty(), xs)
+	}
+}
+
+// SumWithInit sums up init and all values in xs.
+func SumWithInit[T any](s algebra.Semigroup[T]) func(init T, xs *HashSet[T]) T {
+	return func(init T, xs *HashSet[T]) T {
+		return Fold[T, T](init, xs, s.Combine)
+	}
+}
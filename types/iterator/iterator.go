@@ -4,6 +4,7 @@ import (
 	"github.com/genkami/dogs/classes/algebra"
 	"github.com/genkami/dogs/classes/cmp"
 	"github.com/genkami/dogs/types/pair"
+	"github.com/genkami/dogs/types/triple"
 	"golang.org/x/exp/constraints"
 )
 
@@ -40,6 +41,38 @@ func (it *rangeIterator[T]) Next() (T, bool) {
 	return v, true
 }
 
+// Empty returns an Iterator that has no elements.
+func Empty[T any]() Iterator[T] {
+	return emptyIterator[T]{}
+}
+
+type emptyIterator[T any] struct{}
+
+func (emptyIterator[T]) Next() (T, bool) {
+	var zero T
+	return zero, false
+}
+
+// Repeat returns an infinite Iterator that returns x forever.
+func Repeat[T any](x T) Iterator[T] {
+	return &repeatIterator[T]{
+		x: x,
+	}
+}
+
+type repeatIterator[T any] struct {
+	x T
+}
+
+func (it *repeatIterator[T]) Next() (T, bool) {
+	return it.x, true
+}
+
+// RepeatN returns an Iterator that returns x n times.
+func RepeatN[T any](x T, n int) Iterator[T] {
+	return Take(Repeat(x), n)
+}
+
 // Find returns a first element in `it` that satisfies the given predicate `fn`.
 // It returns `false` as a second return value if no elements are found.
 func Find[T any](it Iterator[T], fn func(T) bool) (T, bool) {
@@ -143,7 +176,228 @@ func (it *takeIterator[T]) Next() (T, bool) {
 	return x, true
 }
 
-// TODO: Drop(it, n)
+// Drop skips the first n elements in `it` and returns the rest.
+func Drop[T any](it Iterator[T], n int) Iterator[T] {
+	return &dropIterator[T]{
+		it: it,
+		n:  n,
+	}
+}
+
+type dropIterator[T any] struct {
+	it Iterator[T]
+	n  int
+}
+
+func (it *dropIterator[T]) Next() (T, bool) {
+	for ; 0 < it.n; it.n-- {
+		if _, ok := it.it.Next(); !ok {
+			it.n = 0
+			var zero T
+			return zero, false
+		}
+	}
+	return it.it.Next()
+}
+
+// TakeWhile returns elements in `it` while they satisfy the given predicate fn.
+// It stops at the first element that doesn't satisfy fn, and that element is discarded.
+func TakeWhile[T any](it Iterator[T], fn func(T) bool) Iterator[T] {
+	return &takeWhileIterator[T]{
+		it: it,
+		fn: fn,
+	}
+}
+
+type takeWhileIterator[T any] struct {
+	it       Iterator[T]
+	fn       func(T) bool
+	finished bool
+}
+
+func (it *takeWhileIterator[T]) Next() (T, bool) {
+	var zero T
+	if it.finished {
+		return zero, false
+	}
+	x, ok := it.it.Next()
+	if !ok || !it.fn(x) {
+		it.finished = true
+		return zero, false
+	}
+	return x, true
+}
+
+// DropWhile skips elements in `it` while they satisfy the given predicate fn, and returns the rest.
+func DropWhile[T any](it Iterator[T], fn func(T) bool) Iterator[T] {
+	return &dropWhileIterator[T]{
+		it: it,
+		fn: fn,
+	}
+}
+
+type dropWhileIterator[T any] struct {
+	it      Iterator[T]
+	fn      func(T) bool
+	dropped bool
+}
+
+func (it *dropWhileIterator[T]) Next() (T, bool) {
+	if it.dropped {
+		return it.it.Next()
+	}
+	it.dropped = true
+	return Find(it.it, func(x T) bool { return !it.fn(x) })
+}
+
+// StepBy returns the first element in `it` and then every n-th element after that.
+// It panics if n is not positive.
+func StepBy[T any](it Iterator[T], n int) Iterator[T] {
+	if n <= 0 {
+		panic("iterator.StepBy: step must be positive")
+	}
+	return &stepByIterator[T]{
+		it:    it,
+		n:     n,
+		first: true,
+	}
+}
+
+type stepByIterator[T any] struct {
+	it    Iterator[T]
+	n     int
+	first bool
+}
+
+func (it *stepByIterator[T]) Next() (T, bool) {
+	if it.first {
+		it.first = false
+		return it.it.Next()
+	}
+	return Nth(it.it, it.n-1)
+}
+
+// Chunk splits `it` into consecutive slices of n elements.
+// The last slice may have fewer than n elements.
+// It panics if n is not positive.
+func Chunk[T any](it Iterator[T], n int) Iterator[[]T] {
+	if n <= 0 {
+		panic("iterator.Chunk: size must be positive")
+	}
+	return &chunkIterator[T]{
+		it: it,
+		n:  n,
+	}
+}
+
+type chunkIterator[T any] struct {
+	it       Iterator[T]
+	n        int
+	finished bool
+}
+
+func (it *chunkIterator[T]) Next() ([]T, bool) {
+	if it.finished {
+		return nil, false
+	}
+	chunk := make([]T, 0, it.n)
+	for len(chunk) < it.n {
+		x, ok := it.it.Next()
+		if !ok {
+			it.finished = true
+			break
+		}
+		chunk = append(chunk, x)
+	}
+	if len(chunk) == 0 {
+		return nil, false
+	}
+	return chunk, true
+}
+
+// Windows returns sliding windows of n consecutive elements in `it`.
+// It returns nothing if `it` has fewer than n elements.
+// The returned slice is reused on the next call to Next, so it must be copied in order to retain it.
+// It panics if n is not positive.
+func Windows[T any](it Iterator[T], n int) Iterator[[]T] {
+	if n <= 0 {
+		panic("iterator.Windows: size must be positive")
+	}
+	return &windowsIterator[T]{
+		it:     it,
+		ring:   make([]T, 0, n),
+		window: make([]T, n),
+	}
+}
+
+type windowsIterator[T any] struct {
+	it       Iterator[T]
+	ring     []T
+	head     int
+	window   []T
+	started  bool
+	finished bool
+}
+
+func (it *windowsIterator[T]) Next() ([]T, bool) {
+	if it.finished {
+		return nil, false
+	}
+	for len(it.ring) < cap(it.ring) {
+		x, ok := it.it.Next()
+		if !ok {
+			it.finished = true
+			return nil, false
+		}
+		it.ring = append(it.ring, x)
+	}
+	if it.started {
+		x, ok := it.it.Next()
+		if !ok {
+			it.finished = true
+			return nil, false
+		}
+		// Replace the oldest element.
+		it.ring[it.head] = x
+		it.head = (it.head + 1) % len(it.ring)
+	}
+	it.started = true
+	n := copy(it.window, it.ring[it.head:])
+	copy(it.window[n:], it.ring[:it.head])
+	return it.window, true
+}
+
+// Pairwise returns pairs of consecutive elements in `it`.
+// It returns nothing if `it` has fewer than two elements.
+func Pairwise[T any](it Iterator[T]) Iterator[pair.Pair[T, T]] {
+	return &pairwiseIterator[T]{
+		it: it,
+	}
+}
+
+type pairwiseIterator[T any] struct {
+	it      Iterator[T]
+	prev    T
+	started bool
+}
+
+func (it *pairwiseIterator[T]) Next() (pair.Pair[T, T], bool) {
+	if !it.started {
+		it.started = true
+		x, ok := it.it.Next()
+		if !ok {
+			return pair.Pair[T, T]{}, false
+		}
+		it.prev = x
+	}
+	x, ok := it.it.Next()
+	if !ok {
+		return pair.Pair[T, T]{}, false
+	}
+	p := pair.Pair[T, T]{First: it.prev, Second: x}
+	it.prev = x
+	return p, true
+}
 
 // Map returns an iterator that applies fn to each element of it.
 func Map[T, U any](it Iterator[T], fn func(T) U) Iterator[U] {
@@ -208,6 +462,102 @@ func (it *flatMapIterator[T, U]) Next() (U, bool) {
 	}
 }
 
+// Flatten joins Iterators in `it` into one.
+func Flatten[T any](it Iterator[Iterator[T]]) Iterator[T] {
+	return FlatMap(it, func(x Iterator[T]) Iterator[T] { return x })
+}
+
+// Chain returns an Iterator that returns all elements in the first Iterator, then all elements in the second one, and so on.
+func Chain[T any](its ...Iterator[T]) Iterator[T] {
+	return &chainIterator[T]{
+		its: its,
+	}
+}
+
+type chainIterator[T any] struct {
+	its []Iterator[T]
+}
+
+func (it *chainIterator[T]) Next() (T, bool) {
+	for 0 < len(it.its) {
+		x, ok := it.its[0].Next()
+		if ok {
+			return x, true
+		}
+		it.its = it.its[1:]
+	}
+	var zero T
+	return zero, false
+}
+
+// Concat is the same as Chain.
+func Concat[T any](its ...Iterator[T]) Iterator[T] {
+	return Chain(its...)
+}
+
+// Interleave returns an Iterator that takes elements from each of `its` in turn.
+// Iterators that run out of elements are skipped, and it stops when all of them are exhausted.
+func Interleave[T any](its ...Iterator[T]) Iterator[T] {
+	return &interleaveIterator[T]{
+		its: append([]Iterator[T]{}, its...),
+	}
+}
+
+type interleaveIterator[T any] struct {
+	its []Iterator[T]
+	i   int
+}
+
+func (it *interleaveIterator[T]) Next() (T, bool) {
+	for 0 < len(it.its) {
+		x, ok := it.its[it.i].Next()
+		if ok {
+			it.i = (it.i + 1) % len(it.its)
+			return x, true
+		}
+		it.its = append(it.its[:it.i], it.its[it.i+1:]...)
+		if len(it.its) <= it.i {
+			it.i = 0
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// Cycle returns an Iterator that repeats elements in `it` endlessly.
+// Elements are buffered during the first pass, and the buffer is replayed after `it` is exhausted.
+// It returns nothing if `it` is empty.
+func Cycle[T any](it Iterator[T]) Iterator[T] {
+	return &cycleIterator[T]{
+		it: it,
+	}
+}
+
+type cycleIterator[T any] struct {
+	it        Iterator[T]
+	buf       []T
+	i         int
+	replaying bool
+}
+
+func (it *cycleIterator[T]) Next() (T, bool) {
+	if !it.replaying {
+		x, ok := it.it.Next()
+		if ok {
+			it.buf = append(it.buf, x)
+			return x, true
+		}
+		it.replaying = true
+	}
+	if len(it.buf) == 0 {
+		var zero T
+		return zero, false
+	}
+	x := it.buf[it.i]
+	it.i = (it.i + 1) % len(it.buf)
+	return x, true
+}
+
 // Fold accumulates every element in Iterator by applying fn.
 func Fold[T, U any](init T, it Iterator[U], fn func(T, U) T) T {
 	var acc T = init
@@ -221,6 +571,32 @@ func Fold[T, U any](init T, it Iterator[U], fn func(T, U) T) T {
 	return acc
 }
 
+// Scan is like Fold, except that it returns an Iterator that yields the accumulated value after each element.
+// It does not yield `init` itself.
+func Scan[T, U any](init T, it Iterator[U], fn func(T, U) T) Iterator[T] {
+	return &scanIterator[T, U]{
+		acc: init,
+		it:  it,
+		fn:  fn,
+	}
+}
+
+type scanIterator[T, U any] struct {
+	acc T
+	it  Iterator[U]
+	fn  func(T, U) T
+}
+
+func (it *scanIterator[T, U]) Next() (T, bool) {
+	x, ok := it.it.Next()
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	it.acc = it.fn(it.acc, x)
+	return it.acc, true
+}
+
 // ForEach applies fn to each element in it.
 func ForEach[T any](it Iterator[T], fn func(T)) {
 	for {
@@ -232,32 +608,112 @@ func ForEach[T any](it Iterator[T], fn func(T)) {
 	}
 }
 
+// Nth returns the n-th element (0-indexed) in `it`, consuming elements up to it.
+// It returns false as a second return value if `it` has n or fewer elements, or if n is negative.
+// Nothing is consumed if n is negative.
+func Nth[T any](it Iterator[T], n int) (T, bool) {
+	if n < 0 {
+		var zero T
+		return zero, false
+	}
+	return Drop(it, n).Next()
+}
+
+// Last returns the last element in `it`.
+// It returns false as a second return value if `it` is empty.
+func Last[T any](it Iterator[T]) (T, bool) {
+	var last T
+	found := false
+	ForEach(it, func(x T) {
+		last = x
+		found = true
+	})
+	return last, found
+}
+
+// Count returns the number of elements in `it`.
+func Count[T any](it Iterator[T]) int {
+	return Fold(0, it, func(n int, _ T) int { return n + 1 })
+}
+
+// Any returns true if and only if at least one element in `it` satisfies the given predicate fn.
+// It stops at the first element that satisfies fn.
+func Any[T any](it Iterator[T], fn func(T) bool) bool {
+	_, ok := Find(it, fn)
+	return ok
+}
+
+// All returns true if and only if every element in `it` satisfies the given predicate fn.
+// It stops at the first element that doesn't satisfy fn.
+func All[T any](it Iterator[T], fn func(T) bool) bool {
+	return !Any(it, func(x T) bool { return !fn(x) })
+}
+
 // Zip combines two Iterators into one that yields pairs of corresponding elements.
+// It stops as soon as either of them runs out of elements, so the rest of the longer one is discarded.
 func Zip[T, U any](a Iterator[T], b Iterator[U]) Iterator[pair.Pair[T, U]] {
-	return &zipIterator[T, U]{
-		a: a,
-		b: b,
+	return ZipWith(a, b, func(x T, y U) pair.Pair[T, U] {
+		return pair.Pair[T, U]{First: x, Second: y}
+	})
+}
+
+// ZipWith combines two Iterators into one that yields results of applying fn to corresponding elements.
+// It stops as soon as either of them runs out of elements.
+func ZipWith[T, U, V any](a Iterator[T], b Iterator[U], fn func(T, U) V) Iterator[V] {
+	return &zipWithIterator[T, U, V]{
+		a:  a,
+		b:  b,
+		fn: fn,
 	}
 }
 
-type zipIterator[T, U any] struct {
-	a Iterator[T]
-	b Iterator[U]
+type zipWithIterator[T, U, V any] struct {
+	a  Iterator[T]
+	b  Iterator[U]
+	fn func(T, U) V
 }
 
-func (it *zipIterator[T, U]) Next() (pair.Pair[T, U], bool) {
+func (it *zipWithIterator[T, U, V]) Next() (V, bool) {
+	var zero V
 	x, ok := it.a.Next()
 	if !ok {
-		return pair.Pair[T, U]{}, false
+		return zero, false
 	}
 	y, ok := it.b.Next()
 	if !ok {
-		return pair.Pair[T, U]{}, false
+		return zero, false
 	}
-	return pair.Pair[T, U]{x, y}, true
+	return it.fn(x, y), true
 }
 
-// TODO: ZipWith
+// Zip3 combines three Iterators into one that yields triples of corresponding elements.
+// It stops as soon as any of them runs out of elements.
+func Zip3[T, U, V any](a Iterator[T], b Iterator[U], c Iterator[V]) Iterator[triple.Triple[T, U, V]] {
+	return ZipWith(Zip(a, b), c, func(p pair.Pair[T, U], z V) triple.Triple[T, U, V] {
+		return triple.Triple[T, U, V]{First: p.First, Second: p.Second, Third: z}
+	})
+}
+
+// Unzip splits an Iterator of pairs into two slices of their first and second elements.
+func Unzip[T, U any](it Iterator[pair.Pair[T, U]]) ([]T, []U) {
+	xs := make([]T, 0)
+	ys := make([]U, 0)
+	ForEach(it, func(p pair.Pair[T, U]) {
+		xs = append(xs, p.First)
+		ys = append(ys, p.Second)
+	})
+	return xs, ys
+}
+
+// Enumerate returns an Iterator that yields pairs of the index (starting from 0) and the value of each element in `it`.
+func Enumerate[T any](it Iterator[T]) Iterator[pair.Pair[int, T]] {
+	i := 0
+	return Map(it, func(x T) pair.Pair[int, T] {
+		p := pair.Pair[int, T]{First: i, Second: x}
+		i++
+		return p
+	})
+}
 
 // Unfold returns an Iterator `it` that has an initial state `init` and updating function `step`.
 // On each call to `it.Next()`, it updates its internal state by applying `step` and return the second return value.
@@ -306,6 +762,14 @@ func Sum[T any](m algebra.Monoid[T]) func(it Iterator[T]) T {
 	}
 }
 
+// ScanMonoid returns an Iterator that yields running sums of all values in `it`.
+// The running sums start from `Empty()`, which is not yielded itself.
+func ScanMonoid[T any](m algebra.Monoid[T]) func(it Iterator[T]) Iterator[T] {
+	return func(it Iterator[T]) Iterator[T] {
+		return Scan(m.Empty(), it, m.Combine)
+	}
+}
+
 // Min returns the smallest element with respect to the given Ord.
 // It returns `<zero value>, false` if the iterator is empty.
 func Min[T any](ord cmp.Ord[T]) func(it Iterator[T]) (T, bool) {
@@ -7,6 +7,7 @@ import (
 	"github.com/genkami/dogs/types/iterator"
 	"github.com/genkami/dogs/types/pair"
 	"github.com/genkami/dogs/types/slice"
+	"github.com/genkami/dogs/types/triple"
 	"github.com/stretchr/testify/assert"
 	"strconv"
 	"testing"
@@ -22,6 +23,19 @@ func TestRange(t *testing.T) {
 	assert.Equal(t, subject(1, 0), []int{})
 }
 
+func TestEmpty(t *testing.T) {
+	assert.Equal(t, toSlice(iterator.Empty[int]()), []int{})
+}
+
+func TestRepeat(t *testing.T) {
+	assert.Equal(t, toSlice(iterator.Take(iterator.Repeat("a"), 3)), []string{"a", "a", "a"})
+}
+
+func TestRepeatN(t *testing.T) {
+	assert.Equal(t, toSlice(iterator.RepeatN("a", 0)), []string{})
+	assert.Equal(t, toSlice(iterator.RepeatN("a", 2)), []string{"a", "a"})
+}
+
 func TestFind(t *testing.T) {
 	assertFound := func(name string, xs []int, x int, fn func(int) bool) {
 		t.Run(name, func(t *testing.T) {
@@ -163,6 +177,110 @@ func TestTake(t *testing.T) {
 	assert.Equal(t, subject([]int{3, 4, 5}, 4), []int{3, 4, 5})
 }
 
+func TestDrop(t *testing.T) {
+	subject := func(xs []int, n int) []int {
+		return toSlice(iterator.Drop(slice.Slice[int](xs).Iter(), n))
+	}
+
+	assert.Equal(t, subject([]int{}, 0), []int{})
+	assert.Equal(t, subject([]int{}, 1), []int{})
+
+	assert.Equal(t, subject([]int{3, 4, 5}, 0), []int{3, 4, 5})
+	assert.Equal(t, subject([]int{3, 4, 5}, 1), []int{4, 5})
+	assert.Equal(t, subject([]int{3, 4, 5}, 2), []int{5})
+	assert.Equal(t, subject([]int{3, 4, 5}, 3), []int{})
+	assert.Equal(t, subject([]int{3, 4, 5}, 4), []int{})
+}
+
+func TestTakeWhile(t *testing.T) {
+	isSmall := func(x int) bool { return x < 5 }
+	subject := func(xs ...int) []int {
+		return toSlice(iterator.TakeWhile(slice.Slice[int](xs).Iter(), isSmall))
+	}
+
+	assert.Equal(t, subject(), []int{})
+	assert.Equal(t, subject(1, 2, 3), []int{1, 2, 3})
+	assert.Equal(t, subject(1, 2, 5, 3), []int{1, 2})
+	assert.Equal(t, subject(5, 1, 2), []int{})
+
+	it := iterator.TakeWhile(slice.Slice[int]{1, 5, 2}.Iter(), isSmall)
+	toSlice(it)
+	_, ok := it.Next()
+	assert.False(t, ok, "it should not resume after the predicate fails")
+}
+
+func TestDropWhile(t *testing.T) {
+	isSmall := func(x int) bool { return x < 5 }
+	subject := func(xs ...int) []int {
+		return toSlice(iterator.DropWhile(slice.Slice[int](xs).Iter(), isSmall))
+	}
+
+	assert.Equal(t, subject(), []int{})
+	assert.Equal(t, subject(1, 2, 3), []int{})
+	assert.Equal(t, subject(1, 2, 5, 3), []int{5, 3})
+	assert.Equal(t, subject(5, 1, 2), []int{5, 1, 2})
+}
+
+func TestStepBy(t *testing.T) {
+	subject := func(n int, xs ...int) []int {
+		return toSlice(iterator.StepBy(slice.Slice[int](xs).Iter(), n))
+	}
+
+	assert.Equal(t, subject(2), []int{})
+	assert.Equal(t, subject(1, 1, 2, 3), []int{1, 2, 3})
+	assert.Equal(t, subject(2, 1, 2, 3, 4, 5), []int{1, 3, 5})
+	assert.Equal(t, subject(3, 1, 2, 3, 4, 5, 6), []int{1, 4})
+	assert.Panics(t, func() { iterator.StepBy(slice.Slice[int]{}.Iter(), 0) })
+}
+
+func TestChunk(t *testing.T) {
+	subject := func(n int, xs ...int) [][]int {
+		return toSlice(iterator.Chunk(slice.Slice[int](xs).Iter(), n))
+	}
+
+	assert.Equal(t, subject(2), [][]int{})
+	assert.Equal(t, subject(2, 1), [][]int{{1}})
+	assert.Equal(t, subject(2, 1, 2, 3, 4), [][]int{{1, 2}, {3, 4}})
+	assert.Equal(t, subject(3, 1, 2, 3, 4, 5), [][]int{{1, 2, 3}, {4, 5}})
+	assert.Panics(t, func() { iterator.Chunk(slice.Slice[int]{}.Iter(), 0) })
+}
+
+func TestWindows(t *testing.T) {
+	subject := func(n int, xs ...int) [][]int {
+		windows := iterator.Windows(slice.Slice[int](xs).Iter(), n)
+		return toSlice(iterator.Map(windows, func(w []int) []int {
+			return append([]int{}, w...)
+		}))
+	}
+
+	assert.Equal(t, subject(2), [][]int{})
+	assert.Equal(t, subject(3, 1, 2), [][]int{})
+	assert.Equal(t, subject(3, 1, 2, 3), [][]int{{1, 2, 3}})
+	assert.Equal(t, subject(1, 1, 2, 3), [][]int{{1}, {2}, {3}})
+	assert.Equal(t, subject(3, 1, 2, 3, 4, 5, 6), [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}, {4, 5, 6}})
+	assert.Panics(t, func() { iterator.Windows(slice.Slice[int]{}.Iter(), 0) })
+
+	t.Run("moving sum", func(t *testing.T) {
+		windows := iterator.Windows(slice.Slice[int]{1, 2, 3, 4, 5}.Iter(), 2)
+		sums := iterator.Map(windows, func(w []int) int {
+			return iterator.Sum(algebra.DeriveAdditiveMonoid[int]())(slice.Slice[int](w).Iter())
+		})
+		assert.Equal(t, toSlice(sums), []int{3, 5, 7, 9})
+	})
+}
+
+func TestPairwise(t *testing.T) {
+	type Pair = pair.Pair[int, int]
+	subject := func(xs ...int) []Pair {
+		return toSlice(iterator.Pairwise(slice.Slice[int](xs).Iter()))
+	}
+
+	assert.Equal(t, subject(), []Pair{})
+	assert.Equal(t, subject(1), []Pair{})
+	assert.Equal(t, subject(1, 2), []Pair{{First: 1, Second: 2}})
+	assert.Equal(t, subject(1, 2, 3), []Pair{{First: 1, Second: 2}, {First: 2, Second: 3}})
+}
+
 func TestMap(t *testing.T) {
 	subject := func(xs []int) []string {
 		it := slice.Slice[int](xs).Iter()
@@ -240,6 +358,66 @@ func TestForEach(t *testing.T) {
 	})
 }
 
+func TestFlatten(t *testing.T) {
+	subject := func(xss ...[]int) []int {
+		its := make([]iterator.Iterator[int], 0, len(xss))
+		for _, xs := range xss {
+			its = append(its, slice.Slice[int](xs).Iter())
+		}
+		return toSlice(iterator.Flatten(slice.Slice[iterator.Iterator[int]](its).Iter()))
+	}
+
+	assert.Equal(t, subject(), []int{})
+	assert.Equal(t, subject([]int{}, []int{}), []int{})
+	assert.Equal(t, subject([]int{1, 2}, []int{}, []int{3}), []int{1, 2, 3})
+}
+
+func TestChain(t *testing.T) {
+	subject := func(xss ...[]int) []int {
+		its := make([]iterator.Iterator[int], 0, len(xss))
+		for _, xs := range xss {
+			its = append(its, slice.Slice[int](xs).Iter())
+		}
+		return toSlice(iterator.Chain(its...))
+	}
+
+	assert.Equal(t, subject(), []int{})
+	assert.Equal(t, subject([]int{}, []int{}), []int{})
+	assert.Equal(t, subject([]int{1, 2}), []int{1, 2})
+	assert.Equal(t, subject([]int{1, 2}, []int{}, []int{3}), []int{1, 2, 3})
+}
+
+func TestConcat(t *testing.T) {
+	it := iterator.Concat(slice.Slice[int]{1, 2}.Iter(), iterator.Empty[int](), slice.Slice[int]{3}.Iter())
+	assert.Equal(t, toSlice(it), []int{1, 2, 3})
+}
+
+func TestInterleave(t *testing.T) {
+	subject := func(xss ...[]int) []int {
+		its := make([]iterator.Iterator[int], 0, len(xss))
+		for _, xs := range xss {
+			its = append(its, slice.Slice[int](xs).Iter())
+		}
+		return toSlice(iterator.Interleave(its...))
+	}
+
+	assert.Equal(t, subject(), []int{})
+	assert.Equal(t, subject([]int{}, []int{}), []int{})
+	assert.Equal(t, subject([]int{1, 2, 3}), []int{1, 2, 3})
+	assert.Equal(t, subject([]int{1, 3}, []int{2, 4}), []int{1, 2, 3, 4})
+	assert.Equal(t, subject([]int{1, 4, 6}, []int{}, []int{2}, []int{3, 5}), []int{1, 2, 3, 4, 5, 6})
+}
+
+func TestCycle(t *testing.T) {
+	subject := func(n int, xs ...int) []int {
+		return toSlice(iterator.Take(iterator.Cycle(slice.Slice[int](xs).Iter()), n))
+	}
+
+	assert.Equal(t, subject(3), []int{})
+	assert.Equal(t, subject(3, 1), []int{1, 1, 1})
+	assert.Equal(t, subject(7, 1, 2, 3), []int{1, 2, 3, 1, 2, 3, 1})
+}
+
 func TestFold(t *testing.T) {
 	add := func(x string, y int) string {
 		return x + strconv.FormatInt(int64(y), 10)
@@ -266,6 +444,19 @@ func TestFold(t *testing.T) {
 	})
 }
 
+func TestScan(t *testing.T) {
+	add := func(x string, y int) string {
+		return x + strconv.FormatInt(int64(y), 10)
+	}
+	subject := func(x string, xs ...int) []string {
+		return toSlice(iterator.Scan(x, slice.Slice[int](xs).Iter(), add))
+	}
+
+	assert.Equal(t, subject("a"), []string{})
+	assert.Equal(t, subject("a", 1), []string{"a1"})
+	assert.Equal(t, subject("a", 1, 2, 3), []string{"a1", "a12", "a123"})
+}
+
 func TestZip(t *testing.T) {
 	type Pair = pair.Pair[int, string]
 	subject := func(xs []int, ys []string) []Pair {
@@ -294,6 +485,76 @@ func TestZip(t *testing.T) {
 	})
 }
 
+func TestZipWith(t *testing.T) {
+	subject := func(xs []int, ys []string) []string {
+		xit := slice.Slice[int](xs).Iter()
+		yit := slice.Slice[string](ys).Iter()
+		return toSlice(iterator.ZipWith(xit, yit, func(x int, y string) string {
+			return strconv.Itoa(x) + y
+		}))
+	}
+
+	assert.Equal(t, subject([]int{}, []string{}), []string{})
+	assert.Equal(t, subject([]int{1, 2}, []string{"a", "b"}), []string{"1a", "2b"})
+	assert.Equal(t, subject([]int{1, 2, 3}, []string{"a"}), []string{"1a"})
+	assert.Equal(t, subject([]int{1}, []string{"a", "b"}), []string{"1a"})
+}
+
+func TestZip3(t *testing.T) {
+	type Triple = triple.Triple[int, string, bool]
+	subject := func(xs []int, ys []string, zs []bool) []Triple {
+		return toSlice(iterator.Zip3(
+			slice.Slice[int](xs).Iter(),
+			slice.Slice[string](ys).Iter(),
+			slice.Slice[bool](zs).Iter(),
+		))
+	}
+
+	assert.Equal(t, subject([]int{}, []string{}, []bool{}), []Triple{})
+	assert.Equal(t, subject([]int{1, 2}, []string{"a", "b"}, []bool{true, false}), []Triple{
+		{First: 1, Second: "a", Third: true},
+		{First: 2, Second: "b", Third: false},
+	})
+	assert.Equal(t, subject([]int{1, 2}, []string{"a", "b"}, []bool{true}), []Triple{
+		{First: 1, Second: "a", Third: true},
+	})
+	assert.Equal(t, subject([]int{1}, []string{"a", "b"}, []bool{true, false}), []Triple{
+		{First: 1, Second: "a", Third: true},
+	})
+}
+
+func TestUnzip(t *testing.T) {
+	type Pair = pair.Pair[int, string]
+	t.Run("empty", func(t *testing.T) {
+		xs, ys := iterator.Unzip(slice.Slice[Pair]{}.Iter())
+		assert.Equal(t, xs, []int{})
+		assert.Equal(t, ys, []string{})
+	})
+
+	t.Run("non-empty", func(t *testing.T) {
+		xs, ys := iterator.Unzip(slice.Slice[Pair]{
+			{First: 1, Second: "a"},
+			{First: 2, Second: "b"},
+		}.Iter())
+		assert.Equal(t, xs, []int{1, 2})
+		assert.Equal(t, ys, []string{"a", "b"})
+	})
+}
+
+func TestEnumerate(t *testing.T) {
+	type Pair = pair.Pair[int, string]
+	subject := func(xs ...string) []Pair {
+		return toSlice(iterator.Enumerate(slice.Slice[string](xs).Iter()))
+	}
+
+	assert.Equal(t, subject(), []Pair{})
+	assert.Equal(t, subject("a", "b", "c"), []Pair{
+		{First: 0, Second: "a"},
+		{First: 1, Second: "b"},
+		{First: 2, Second: "c"},
+	})
+}
+
 func TestUnfold(t *testing.T) {
 	subject := func(init int, step func(int) (int, int, bool)) []int {
 		return toSlice[int](iterator.Unfold[int, int](init, step))
@@ -368,6 +629,16 @@ func TestSum(t *testing.T) {
 	})
 }
 
+func TestScanMonoid(t *testing.T) {
+	subject := func(xs ...int) []int {
+		return toSlice(iterator.ScanMonoid(algebra.DeriveAdditiveMonoid[int]())(slice.Slice[int](xs).Iter()))
+	}
+
+	assert.Equal(t, subject(), []int{})
+	assert.Equal(t, subject(1), []int{1})
+	assert.Equal(t, subject(1, 2, 3, 4), []int{1, 3, 6, 10})
+}
+
 func TestMin(t *testing.T) {
 	ord := cmp.DeriveOrd[int]()
 	assertFound := func(name string, xs []int, expected int) {
@@ -451,6 +722,60 @@ func TestPure(t *testing.T) {
 	assert.Equal(t, subject(3), []int{3})
 }
 
+func TestNth(t *testing.T) {
+	x, ok := iterator.Nth(slice.Slice[int]{3, 4, 5}.Iter(), 0)
+	assert.True(t, ok)
+	assert.Equal(t, x, 3)
+
+	x, ok = iterator.Nth(slice.Slice[int]{3, 4, 5}.Iter(), 2)
+	assert.True(t, ok)
+	assert.Equal(t, x, 5)
+
+	_, ok = iterator.Nth(slice.Slice[int]{3, 4, 5}.Iter(), 3)
+	assert.False(t, ok)
+
+	it := slice.Slice[int]{3, 4, 5}.Iter()
+	_, ok = iterator.Nth(it, -1)
+	assert.False(t, ok)
+	assert.Equal(t, toSlice(it), []int{3, 4, 5}, "it should consume nothing if n is negative")
+
+	it = slice.Slice[int]{3, 4, 5}.Iter()
+	iterator.Nth(it, 1)
+	assert.Equal(t, toSlice(it), []int{5}, "it should consume elements up to the n-th one")
+}
+
+func TestLast(t *testing.T) {
+	x, ok := iterator.Last(slice.Slice[int]{3, 4, 5}.Iter())
+	assert.True(t, ok)
+	assert.Equal(t, x, 5)
+
+	_, ok = iterator.Last(slice.Slice[int]{}.Iter())
+	assert.False(t, ok)
+}
+
+func TestCount(t *testing.T) {
+	assert.Equal(t, iterator.Count(slice.Slice[int]{}.Iter()), 0)
+	assert.Equal(t, iterator.Count(slice.Slice[int]{3, 4, 5}.Iter()), 3)
+}
+
+func TestAny(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+	assert.False(t, iterator.Any(slice.Slice[int]{}.Iter(), isEven))
+	assert.False(t, iterator.Any(slice.Slice[int]{1, 3}.Iter(), isEven))
+	assert.True(t, iterator.Any(slice.Slice[int]{1, 2, 3}.Iter(), isEven))
+
+	it := slice.Slice[int]{1, 2, 3}.Iter()
+	iterator.Any(it, isEven)
+	assert.Equal(t, toSlice(it), []int{3}, "it should stop at the first element that satisfies fn")
+}
+
+func TestAll(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+	assert.True(t, iterator.All(slice.Slice[int]{}.Iter(), isEven))
+	assert.True(t, iterator.All(slice.Slice[int]{2, 4}.Iter(), isEven))
+	assert.False(t, iterator.All(slice.Slice[int]{2, 3, 4}.Iter(), isEven))
+}
+
 func toSlice[T any](it iterator.Iterator[T]) []T {
 	return iterator.Fold[[]T, T](
 		make([]T, 0),
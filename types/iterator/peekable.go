@@ -0,0 +1,83 @@
+package iterator
+
+// Peekable is an Iterator that allows looking ahead at the next element without consuming it.
+type Peekable[T any] struct {
+	it Iterator[T]
+	// buf holds elements that are peeked or put back; the last one is returned first.
+	buf []T
+}
+
+// NewPeekable returns a Peekable that wraps `it`.
+func NewPeekable[T any](it Iterator[T]) *Peekable[T] {
+	return &Peekable[T]{
+		it: it,
+	}
+}
+
+// Next returns the next element and advances its state.
+func (p *Peekable[T]) Next() (T, bool) {
+	if n := len(p.buf); 0 < n {
+		x := p.buf[n-1]
+		p.buf = p.buf[:n-1]
+		return x, true
+	}
+	return p.it.Next()
+}
+
+// Peek returns the next element without consuming it.
+// It returns false as a second return value if there are no elements to return.
+func (p *Peekable[T]) Peek() (T, bool) {
+	if n := len(p.buf); 0 < n {
+		return p.buf[n-1], true
+	}
+	x, ok := p.it.Next()
+	if !ok {
+		return x, false
+	}
+	p.buf = append(p.buf, x)
+	return x, true
+}
+
+// NextIf consumes and returns the next element only if it satisfies the given predicate fn.
+// Otherwise the element is left as it is, and it returns false as a second return value.
+func (p *Peekable[T]) NextIf(fn func(T) bool) (T, bool) {
+	x, ok := p.Peek()
+	if !ok || !fn(x) {
+		var zero T
+		return zero, false
+	}
+	return p.Next()
+}
+
+// PutBack pushes x back so that it is returned by the next call to Next or Peek.
+// Elements put back more than once are returned in the reverse order.
+func (p *Peekable[T]) PutBack(x T) {
+	p.buf = append(p.buf, x)
+}
+
+// TakeWhileRef is like TakeWhile, except that the first element that doesn't satisfy fn is left in `p`.
+func TakeWhileRef[T any](p *Peekable[T], fn func(T) bool) Iterator[T] {
+	return &takeWhileRefIterator[T]{
+		p:  p,
+		fn: fn,
+	}
+}
+
+type takeWhileRefIterator[T any] struct {
+	p        *Peekable[T]
+	fn       func(T) bool
+	finished bool
+}
+
+func (it *takeWhileRefIterator[T]) Next() (T, bool) {
+	var zero T
+	if it.finished {
+		return zero, false
+	}
+	x, ok := it.p.NextIf(it.fn)
+	if !ok {
+		it.finished = true
+		return zero, false
+	}
+	return x, true
+}
@@ -0,0 +1,58 @@
+package iterator_test
+
+import (
+	"github.com/genkami/dogs/types/iterator"
+	"github.com/genkami/dogs/types/slice"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"unicode"
+)
+
+func TestPeekable(t *testing.T) {
+	t.Run("Peek", func(t *testing.T) {
+		p := iterator.NewPeekable(slice.Slice[int]{1, 2}.Iter())
+		x, ok := p.Peek()
+		assert.True(t, ok)
+		assert.Equal(t, x, 1)
+		x, ok = p.Peek()
+		assert.True(t, ok)
+		assert.Equal(t, x, 1)
+		assert.Equal(t, toSlice[int](p), []int{1, 2})
+		_, ok = p.Peek()
+		assert.False(t, ok)
+	})
+
+	t.Run("NextIf", func(t *testing.T) {
+		p := iterator.NewPeekable(slice.Slice[int]{1, 2}.Iter())
+		isOdd := func(x int) bool { return x%2 != 0 }
+		x, ok := p.NextIf(isOdd)
+		assert.True(t, ok)
+		assert.Equal(t, x, 1)
+		_, ok = p.NextIf(isOdd)
+		assert.False(t, ok)
+		assert.Equal(t, toSlice[int](p), []int{2})
+		_, ok = p.NextIf(isOdd)
+		assert.False(t, ok)
+	})
+
+	t.Run("PutBack", func(t *testing.T) {
+		p := iterator.NewPeekable(slice.Slice[int]{1, 2}.Iter())
+		x, _ := p.Next()
+		p.PutBack(x)
+		p.PutBack(0)
+		x, ok := p.Peek()
+		assert.True(t, ok)
+		assert.Equal(t, x, 0)
+		assert.Equal(t, toSlice[int](p), []int{0, 1, 2})
+	})
+}
+
+func TestTakeWhileRef(t *testing.T) {
+	p := iterator.NewPeekable(slice.Slice[rune]("12ab3").Iter())
+	assert.Equal(t, string(toSlice(iterator.TakeWhileRef(p, unicode.IsDigit))), "12")
+	assert.Equal(t, string(toSlice(iterator.TakeWhileRef(p, unicode.IsLetter))), "ab")
+	assert.Equal(t, string(toSlice(iterator.TakeWhileRef(p, unicode.IsLetter))), "")
+	assert.Equal(t, string(toSlice(iterator.TakeWhileRef(p, unicode.IsDigit))), "3")
+	_, ok := p.Peek()
+	assert.False(t, ok)
+}
@@ -0,0 +1,82 @@
+package iterator
+
+import (
+	"github.com/genkami/dogs/types/pair"
+	"iter"
+	"runtime"
+)
+
+// FromSeq returns an Iterator that yields the same elements as seq, and a function to stop iterating.
+// Resources held by seq are released when the returned Iterator is exhausted or stop is called.
+// The caller should call stop if it may not exhaust the Iterator; after that, the Iterator yields no more elements.
+// As a safety net, they are also released after the Iterator becomes unreachable.
+func FromSeq[T any](seq iter.Seq[T]) (it Iterator[T], stop func()) {
+	next, stopPull := iter.Pull(seq)
+	s := &seqIterator[T]{
+		next: next,
+		stop: stopPull,
+	}
+	runtime.AddCleanup(s, func(stop func()) { stop() }, stopPull)
+	return s, s.release
+}
+
+type seqIterator[T any] struct {
+	next     func() (T, bool)
+	stop     func()
+	finished bool
+}
+
+func (it *seqIterator[T]) Next() (T, bool) {
+	if it.finished {
+		var zero T
+		return zero, false
+	}
+	x, ok := it.next()
+	if !ok {
+		it.release()
+	}
+	return x, ok
+}
+
+func (it *seqIterator[T]) release() {
+	it.finished = true
+	it.stop()
+}
+
+// FromSeq2 returns an Iterator that yields pairs of elements in seq, and a function to stop iterating.
+// See FromSeq for details.
+func FromSeq2[K, V any](seq iter.Seq2[K, V]) (it Iterator[pair.Pair[K, V]], stop func()) {
+	return FromSeq(func(yield func(pair.Pair[K, V]) bool) {
+		for k, v := range seq {
+			if !yield(pair.Pair[K, V]{First: k, Second: v}) {
+				return
+			}
+		}
+	})
+}
+
+// ToSeq returns an iter.Seq that yields the remaining elements in `it`.
+// Since `it` is consumed while iterating, the returned iter.Seq can be used only once.
+func ToSeq[T any](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			x, ok := it.Next()
+			if !ok || !yield(x) {
+				return
+			}
+		}
+	}
+}
+
+// ToSeq2 returns an iter.Seq2 that yields the remaining pairs in `it`.
+// Since `it` is consumed while iterating, the returned iter.Seq2 can be used only once.
+func ToSeq2[K, V any](it Iterator[pair.Pair[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for {
+			p, ok := it.Next()
+			if !ok || !yield(p.First, p.Second) {
+				return
+			}
+		}
+	}
+}
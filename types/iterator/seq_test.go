@@ -0,0 +1,80 @@
+package iterator_test
+
+import (
+	"github.com/genkami/dogs/types/iterator"
+	"github.com/genkami/dogs/types/pair"
+	"github.com/genkami/dogs/types/slice"
+	"github.com/stretchr/testify/assert"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestFromSeq(t *testing.T) {
+	subject := func(xs ...int) []int {
+		it, stop := iterator.FromSeq(slices.Values(xs))
+		defer stop()
+		return toSlice(it)
+	}
+
+	assert.Equal(t, subject(), []int{})
+	assert.Equal(t, subject(1), []int{1})
+	assert.Equal(t, subject(1, 2, 3), []int{1, 2, 3})
+
+	t.Run("partially consumed", func(t *testing.T) {
+		it, stop := iterator.FromSeq(slices.Values([]int{1, 2, 3}))
+		defer stop()
+		assert.Equal(t, toSlice(iterator.Take(it, 2)), []int{1, 2})
+		assert.Equal(t, toSlice(it), []int{3})
+	})
+
+	t.Run("stop", func(t *testing.T) {
+		cleanedUp := false
+		seq := func(yield func(int) bool) {
+			defer func() { cleanedUp = true }()
+			for i := 0; yield(i); i++ {
+			}
+		}
+		it, stop := iterator.FromSeq(seq)
+		assert.Equal(t, toSlice(iterator.Take(it, 2)), []int{0, 1})
+		assert.False(t, cleanedUp)
+		stop()
+		assert.True(t, cleanedUp)
+		assert.Equal(t, toSlice(it), []int{})
+		stop()
+	})
+}
+
+func TestFromSeq2(t *testing.T) {
+	type Pair = pair.Pair[int, string]
+	it, stop := iterator.FromSeq2(slices.All([]string{"a", "b"}))
+	defer stop()
+	assert.Equal(t, toSlice(it), []Pair{{First: 0, Second: "a"}, {First: 1, Second: "b"}})
+}
+
+func TestToSeq(t *testing.T) {
+	subject := func(xs ...int) []int {
+		return slices.Collect(iterator.ToSeq(slice.Slice[int](xs).Iter()))
+	}
+
+	assert.Equal(t, subject(), []int(nil))
+	assert.Equal(t, subject(1), []int{1})
+	assert.Equal(t, subject(1, 2, 3), []int{1, 2, 3})
+
+	t.Run("break", func(t *testing.T) {
+		it := slice.Slice[int]{1, 2, 3}.Iter()
+		for x := range iterator.ToSeq(it) {
+			if x == 2 {
+				break
+			}
+		}
+		assert.Equal(t, toSlice(it), []int{3})
+	})
+}
+
+func TestToSeq2(t *testing.T) {
+	type Pair = pair.Pair[string, int]
+	it := slice.Slice[Pair]{{First: "a", Second: 1}, {First: "b", Second: 2}}.Iter()
+	m := maps.Collect(iterator.ToSeq2(it))
+	assert.Equal(t, m, map[string]int{"a": 1, "b": 2})
+}
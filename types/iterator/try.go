@@ -0,0 +1,239 @@
+package iterator
+
+// TryIterator iterates over some set of elements and may fail while iterating.
+type TryIterator[T any] interface {
+	// Next returns the next element in this TryIterator and advances its state.
+	// The second return value is false if and only if there are no elements to return.
+	// The third return value is non-nil if the iteration fails, in which case the second return value is false.
+	Next() (T, bool, error)
+}
+
+// Fallible converts an Iterator into a TryIterator that never fails.
+func Fallible[T any](it Iterator[T]) TryIterator[T] {
+	return &fallibleIterator[T]{
+		it: it,
+	}
+}
+
+type fallibleIterator[T any] struct {
+	it Iterator[T]
+}
+
+func (it *fallibleIterator[T]) Next() (T, bool, error) {
+	x, ok := it.it.Next()
+	return x, ok, nil
+}
+
+// TryUnfold is a fallible version of Unfold.
+// If `step` returns a non-nil error, `it.Next()` stops iterating and returns the error.
+func TryUnfold[T, U any](init T, step func(T) (T, U, bool, error)) TryIterator[U] {
+	return &tryUnfoldIterator[T, U]{
+		state: init,
+		step:  step,
+	}
+}
+
+type tryUnfoldIterator[T, U any] struct {
+	state    T
+	step     func(T) (T, U, bool, error)
+	err      error
+	finished bool
+}
+
+func (it *tryUnfoldIterator[T, U]) Next() (U, bool, error) {
+	var zero U
+	if it.finished {
+		return zero, false, it.err
+	}
+	state, next, ok, err := it.step(it.state)
+	if err != nil {
+		it.finished = true
+		it.err = err
+		return zero, false, err
+	}
+	if !ok {
+		it.finished = true
+		return zero, false, nil
+	}
+	it.state = state
+	return next, true, nil
+}
+
+// TryFind returns a first element in `it` that satisfies the given predicate `fn`.
+// It returns `false` as a second return value if no elements are found.
+// It stops at the first error that either `it` or `fn` returns.
+func TryFind[T any](it TryIterator[T], fn func(T) (bool, error)) (T, bool, error) {
+	var zero T
+	for {
+		x, ok, err := it.Next()
+		if err != nil {
+			return zero, false, err
+		}
+		if !ok {
+			return zero, false, nil
+		}
+		found, err := fn(x)
+		if err != nil {
+			return zero, false, err
+		}
+		if found {
+			return x, true, nil
+		}
+	}
+}
+
+// TryFilter returns a TryIterator that only returns elements that satisfies given predicate.
+// It stops at the first error that either `it` or `fn` returns.
+func TryFilter[T any](it TryIterator[T], fn func(T) (bool, error)) TryIterator[T] {
+	return &tryFilterIterator[T]{
+		it: it,
+		fn: fn,
+	}
+}
+
+type tryFilterIterator[T any] struct {
+	it       TryIterator[T]
+	fn       func(T) (bool, error)
+	err      error
+	finished bool
+}
+
+func (it *tryFilterIterator[T]) Next() (T, bool, error) {
+	var zero T
+	if it.finished {
+		return zero, false, it.err
+	}
+	x, ok, err := TryFind(it.it, it.fn)
+	if err != nil || !ok {
+		it.finished = true
+		it.err = err
+		return zero, false, err
+	}
+	return x, true, nil
+}
+
+// TryMap returns a TryIterator that applies fn to each element of it.
+// It stops at the first error that either `it` or `fn` returns.
+func TryMap[T, U any](it TryIterator[T], fn func(T) (U, error)) TryIterator[U] {
+	return &tryMapIterator[T, U]{
+		it: it,
+		fn: fn,
+	}
+}
+
+type tryMapIterator[T, U any] struct {
+	it       TryIterator[T]
+	fn       func(T) (U, error)
+	err      error
+	finished bool
+}
+
+func (it *tryMapIterator[T, U]) Next() (U, bool, error) {
+	var zero U
+	if it.finished {
+		return zero, false, it.err
+	}
+	x, ok, err := it.it.Next()
+	if err == nil && ok {
+		var y U
+		y, err = it.fn(x)
+		if err == nil {
+			return y, true, nil
+		}
+	}
+	it.finished = true
+	it.err = err
+	return zero, false, err
+}
+
+// TryFlatMap applies fn to each element in it and then joins them.
+// It stops at the first error that `it`, `fn` or any of TryIterators returned by `fn` returns.
+func TryFlatMap[T, U any](it TryIterator[T], fn func(T) (TryIterator[U], error)) TryIterator[U] {
+	return &tryFlatMapIterator[T, U]{
+		it: it,
+		fn: fn,
+	}
+}
+
+type tryFlatMapIterator[T, U any] struct {
+	it       TryIterator[T]
+	cur      TryIterator[U]
+	fn       func(T) (TryIterator[U], error)
+	err      error
+	finished bool
+}
+
+func (it *tryFlatMapIterator[T, U]) Next() (U, bool, error) {
+	var zero U
+	if it.finished {
+		return zero, false, it.err
+	}
+	for {
+		if it.cur != nil {
+			x, ok, err := it.cur.Next()
+			if err != nil {
+				return it.fail(err)
+			}
+			if ok {
+				return x, true, nil
+			}
+		}
+		// cur == nil or cur is finished
+		next, ok, err := it.it.Next()
+		if err != nil {
+			return it.fail(err)
+		}
+		if !ok {
+			it.finished = true
+			return zero, false, nil
+		}
+		it.cur, err = it.fn(next)
+		if err != nil {
+			return it.fail(err)
+		}
+	}
+}
+
+func (it *tryFlatMapIterator[T, U]) fail(err error) (U, bool, error) {
+	var zero U
+	it.finished = true
+	it.err = err
+	return zero, false, err
+}
+
+// TryFold accumulates every element in TryIterator by applying fn.
+// It stops at the first error that either `it` or `fn` returns, and returns the error with the accumulated value so far.
+func TryFold[T, U any](init T, it TryIterator[U], fn func(T, U) (T, error)) (T, error) {
+	var acc T = init
+	for {
+		x, ok, err := it.Next()
+		if err != nil {
+			return acc, err
+		}
+		if !ok {
+			return acc, nil
+		}
+		next, err := fn(acc, x)
+		if err != nil {
+			return acc, err
+		}
+		acc = next
+	}
+}
+
+// TryForEach applies fn to each element in it.
+// It stops at the first error that either `it` or `fn` returns.
+func TryForEach[T any](it TryIterator[T], fn func(T) error) error {
+	for {
+		x, ok, err := it.Next()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		if err := fn(x); err != nil {
+			return err
+		}
+	}
+}
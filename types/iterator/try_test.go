@@ -0,0 +1,203 @@
+package iterator_test
+
+import (
+	"errors"
+	"github.com/genkami/dogs/types/iterator"
+	"github.com/genkami/dogs/types/slice"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+var errTest = errors.New("test error")
+
+// failAt returns a TryIterator that returns xs[0], ..., xs[n-1] and then fails.
+// It does not fail if n is negative.
+func failAt(n int, xs ...int) iterator.TryIterator[int] {
+	return iterator.TryUnfold(0, func(i int) (int, int, bool, error) {
+		if i == n {
+			return 0, 0, false, errTest
+		}
+		if len(xs) <= i {
+			return 0, 0, false, nil
+		}
+		return i + 1, xs[i], true, nil
+	})
+}
+
+func TestFallible(t *testing.T) {
+	xs, err := tryToSlice(iterator.Fallible(slice.Slice[int]{1, 2, 3}.Iter()))
+	assert.NoError(t, err)
+	assert.Equal(t, xs, []int{1, 2, 3})
+}
+
+func TestTryUnfold(t *testing.T) {
+	xs, err := tryToSlice(failAt(-1, 1, 2, 3))
+	assert.NoError(t, err)
+	assert.Equal(t, xs, []int{1, 2, 3})
+
+	it := failAt(2, 1, 2, 3)
+	xs, err = tryToSlice(it)
+	assert.Equal(t, err, errTest)
+	assert.Equal(t, xs, []int{1, 2})
+
+	_, ok, err := it.Next()
+	assert.False(t, ok)
+	assert.Equal(t, err, errTest, "the error should be returned again")
+}
+
+func TestTryFind(t *testing.T) {
+	isEven := func(x int) (bool, error) { return x%2 == 0, nil }
+
+	x, ok, err := iterator.TryFind(failAt(-1, 1, 2, 3), isEven)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, x, 2)
+
+	_, ok, err = iterator.TryFind(failAt(-1, 1, 3), isEven)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	_, ok, err = iterator.TryFind(failAt(1, 1, 2, 3), isEven)
+	assert.Equal(t, err, errTest)
+	assert.False(t, ok)
+
+	_, ok, err = iterator.TryFind(failAt(-1, 1, 2, 3), func(int) (bool, error) { return false, errTest })
+	assert.Equal(t, err, errTest)
+	assert.False(t, ok)
+}
+
+func TestTryFilter(t *testing.T) {
+	isEven := func(x int) (bool, error) { return x%2 == 0, nil }
+
+	xs, err := tryToSlice(iterator.TryFilter(failAt(-1, 1, 2, 3, 4), isEven))
+	assert.NoError(t, err)
+	assert.Equal(t, xs, []int{2, 4})
+
+	xs, err = tryToSlice(iterator.TryFilter(failAt(3, 1, 2, 3, 4), isEven))
+	assert.Equal(t, err, errTest)
+	assert.Equal(t, xs, []int{2})
+
+	xs, err = tryToSlice(iterator.TryFilter(failAt(-1, 1, 2, 3, 4), func(x int) (bool, error) {
+		if x == 3 {
+			return false, errTest
+		}
+		return true, nil
+	}))
+	assert.Equal(t, err, errTest)
+	assert.Equal(t, xs, []int{1, 2})
+}
+
+func TestTryMap(t *testing.T) {
+	subject := func(xs ...string) ([]int, error) {
+		it := iterator.Fallible(slice.Slice[string](xs).Iter())
+		return tryToSlice(iterator.TryMap(it, strconv.Atoi))
+	}
+
+	xs, err := subject()
+	assert.NoError(t, err)
+	assert.Equal(t, xs, []int{})
+
+	xs, err = subject("1", "2", "3")
+	assert.NoError(t, err)
+	assert.Equal(t, xs, []int{1, 2, 3})
+
+	xs, err = subject("1", "two", "3")
+	assert.Error(t, err)
+	assert.Equal(t, xs, []int{1})
+
+	xs, err = tryToSlice(iterator.TryMap(failAt(1, 1, 2), func(x int) (int, error) { return x * 2, nil }))
+	assert.Equal(t, err, errTest)
+	assert.Equal(t, xs, []int{2})
+}
+
+func TestTryFlatMap(t *testing.T) {
+	repeat := func(x int) (iterator.TryIterator[int], error) {
+		xs := make([]int, 0, x)
+		for i := 0; i < x; i++ {
+			xs = append(xs, x)
+		}
+		return failAt(-1, xs...), nil
+	}
+
+	xs, err := tryToSlice(iterator.TryFlatMap(failAt(-1, 2, 0, 3), repeat))
+	assert.NoError(t, err)
+	assert.Equal(t, xs, []int{2, 2, 3, 3, 3})
+
+	xs, err = tryToSlice(iterator.TryFlatMap(failAt(1, 2, 0, 3), repeat))
+	assert.Equal(t, err, errTest)
+	assert.Equal(t, xs, []int{2, 2})
+
+	xs, err = tryToSlice(iterator.TryFlatMap(failAt(-1, 2, 3), func(x int) (iterator.TryIterator[int], error) {
+		return failAt(1, x, x), nil
+	}))
+	assert.Equal(t, err, errTest)
+	assert.Equal(t, xs, []int{2})
+
+	xs, err = tryToSlice(iterator.TryFlatMap(failAt(-1, 2, 3), func(x int) (iterator.TryIterator[int], error) {
+		if x == 3 {
+			return nil, errTest
+		}
+		return repeat(x)
+	}))
+	assert.Equal(t, err, errTest)
+	assert.Equal(t, xs, []int{2, 2})
+}
+
+func TestTryFold(t *testing.T) {
+	add := func(x, y int) (int, error) { return x + y, nil }
+
+	sum, err := iterator.TryFold(0, failAt(-1, 1, 2, 3), add)
+	assert.NoError(t, err)
+	assert.Equal(t, sum, 6)
+
+	sum, err = iterator.TryFold(0, failAt(2, 1, 2, 3), add)
+	assert.Equal(t, err, errTest)
+	assert.Equal(t, sum, 3)
+
+	sum, err = iterator.TryFold(0, failAt(-1, 1, 2, 3), func(x, y int) (int, error) {
+		if y == 2 {
+			return 0, errTest
+		}
+		return x + y, nil
+	})
+	assert.Equal(t, err, errTest)
+	assert.Equal(t, sum, 1)
+}
+
+func TestTryForEach(t *testing.T) {
+	args := []int{}
+	err := iterator.TryForEach(failAt(-1, 1, 2, 3), func(x int) error {
+		args = append(args, x)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, args, []int{1, 2, 3})
+
+	args = []int{}
+	err = iterator.TryForEach(failAt(2, 1, 2, 3), func(x int) error {
+		args = append(args, x)
+		return nil
+	})
+	assert.Equal(t, err, errTest)
+	assert.Equal(t, args, []int{1, 2})
+
+	args = []int{}
+	err = iterator.TryForEach(failAt(-1, 1, 2, 3), func(x int) error {
+		args = append(args, x)
+		if x == 2 {
+			return errTest
+		}
+		return nil
+	})
+	assert.Equal(t, err, errTest)
+	assert.Equal(t, args, []int{1, 2})
+}
+
+func tryToSlice[T any](it iterator.TryIterator[T]) ([]T, error) {
+	return iterator.TryFold[[]T, T](
+		make([]T, 0),
+		it,
+		func(xs []T, x T) ([]T, error) { return append(xs, x), nil },
+	)
+}
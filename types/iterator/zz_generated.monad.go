@@ -6,6 +6,7 @@ import (
 	"github.com/genkami/dogs/classes/algebra"
 	"github.com/genkami/dogs/classes/cmp"
 	"github.com/genkami/dogs/types/pair"
+	"iter"
 )
 
 // Some packages are unused depending on -include CLI option.
@@ -14,6 +15,7 @@ var _ = (algebra.Monoid[int])(nil)
 var _ = (cmp.Ord[int])(nil)
 var _ = (Iterator[int])(nil)
 var _ = (*pair.Pair[int, int])(nil)
+var _ = (iter.Seq[int])(nil)
 
 // LiftM promotes a function fn to a monad.
 func LiftM[T, U any](fn func(T) U) func(Iterator[T]) Iterator[U] {
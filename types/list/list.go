@@ -1,6 +1,13 @@
 package list
 
-import "github.com/genkami/dogs/types/iterator"
+import (
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/classes/hash"
+	"github.com/genkami/dogs/types/iterator"
+	"strings"
+)
 
 // List is a linked list.
 // An empty list is represented as nil.
@@ -58,3 +65,78 @@ func (it *listIterator[T]) Next() (T, bool) {
 	it.cur = it.cur.Tail
 	return cur.Head, true
 }
+
+// DeriveEq derives Eq[*List[T]] from Eq[T].
+// Two lists are equal if they have the same length and their elements are pairwise equal in terms of eq.
+func DeriveEq[T any](eq cmp.Eq[T]) cmp.Eq[*List[T]] {
+	return &cmp.DefaultEq[*List[T]]{
+		EqualImpl: func(xs, ys *List[T]) bool {
+			for ; xs != nil && ys != nil; xs, ys = xs.Tail, ys.Tail {
+				if !eq.Equal(xs.Head, ys.Head) {
+					return false
+				}
+			}
+			return xs == nil && ys == nil
+		},
+	}
+}
+
+// DeriveOrd derives Ord[*List[T]] from Ord[T].
+// Lists are compared lexicographically, that is, they are compared by their first elements that differ,
+// and a list is less than another one if the former is a prefix of the latter.
+func DeriveOrd[T any](ord cmp.Ord[T]) cmp.Ord[*List[T]] {
+	return &cmp.DefaultOrd[*List[T]]{
+		CompareImpl: func(xs, ys *List[T]) cmp.Ordering {
+			for ; xs != nil && ys != nil; xs, ys = xs.Tail, ys.Tail {
+				if result := ord.Compare(xs.Head, ys.Head); result != cmp.EQ {
+					return result
+				}
+			}
+			if xs != nil {
+				return cmp.GT
+			} else if ys != nil {
+				return cmp.LT
+			} else {
+				return cmp.EQ
+			}
+		},
+	}
+}
+
+// DeriveHash derives Hash[*List[T]] from Hash[T].
+func DeriveHash[T any](h hash.Hash[T]) hash.Hash[*List[T]] {
+	var eq cmp.Eq[T] = h
+	return &hash.DefaultHash[*List[T]]{
+		Eq: DeriveEq(eq),
+		HashImpl: func(xs *List[T]) uint64 {
+			acc := hash.Empty
+			for ; xs != nil; xs = xs.Tail {
+				acc = hash.Combine(acc, h.Hash(xs.Head))
+			}
+			return acc
+		},
+	}
+}
+
+// DeriveDebug derives Debug[*List[T]] from Debug[T].
+// It formats values as `List[<elem>, <elem>, ...]`.
+func DeriveDebug[T any](d debug.Debug[T]) debug.Debug[*List[T]] {
+	return &debug.DefaultDebug[*List[T]]{
+		DebugFmtImpl: func(xs *List[T]) string {
+			elems := Fold[[]string, T](nil, xs, func(acc []string, x T) []string {
+				return append(acc, d.DebugFmt(x))
+			})
+			return "List[" + strings.Join(elems, ", ") + "]"
+		},
+	}
+}
+
+// DeriveArbitrary derives Arbitrary[*List[T]] from Arbitrary[T].
+func DeriveArbitrary[T any](a arbitrary.Arbitrary[T]) arbitrary.Arbitrary[*List[T]] {
+	return arbitrary.Convert(arbitrary.SliceOf(a),
+		func(xs []T) *List[T] { return New(xs...) },
+		func(xs *List[T]) []T {
+			return Fold[[]T, T](nil, xs, func(acc []T, x T) []T { return append(acc, x) })
+		},
+	)
+}
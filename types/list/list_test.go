@@ -1,9 +1,16 @@
 package list_test
 
 import (
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/cmp/cmptest"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/classes/hash"
 	"github.com/genkami/dogs/types/list"
 	"github.com/genkami/dogs/types/slice"
 	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"slices"
 	"testing"
 )
 
@@ -56,3 +63,78 @@ func TestList_Iter(t *testing.T) {
 		assert.False(t, ok)
 	})
 }
+
+func TestList_All(t *testing.T) {
+	subject := func(xs ...int) []int {
+		return slices.Collect(list.New[int](xs...).All())
+	}
+
+	assert.Equal(t, subject(), []int(nil))
+	assert.Equal(t, subject(1), []int{1})
+	assert.Equal(t, subject(1, 2, 3), []int{1, 2, 3})
+}
+
+func TestDeriveDebug(t *testing.T) {
+	d := list.DeriveDebug(debug.DeriveDebug[string]())
+	assert.Equal(t, d.DebugFmt(list.New[string]()), "List[]")
+	assert.Equal(t, d.DebugFmt(list.New[string]("a")), `List["a"]`)
+	assert.Equal(t, d.DebugFmt(list.New[string]("a", "b", "c")), `List["a", "b", "c"]`)
+}
+
+func TestDeriveArbitrary(t *testing.T) {
+	a := list.DeriveArbitrary(arbitrary.Int[int]())
+	choose := func() *list.List[int] {
+		return a.Choose(rand.New(rand.NewSource(42)))
+	}
+	assert.Equal(t, choose(), choose())
+	assert.Empty(t, a.Shrink(list.New[int]()))
+	assert.Equal(t, a.Shrink(list.New[int](1)), []*list.List[int]{list.New[int](), list.New[int](0)})
+}
+
+func TestDeriveEq(t *testing.T) {
+	eq := list.DeriveEq(cmp.DeriveEq[int]())
+	assert.True(t, eq.Equal(nil, nil))
+	assert.True(t, eq.Equal(list.New(1, 2), list.New(1, 2)))
+	assert.False(t, eq.Equal(list.New(1, 2), list.New(1, 3)))
+	assert.False(t, eq.Equal(list.New(1, 2), list.New(1, 2, 3)))
+	assert.False(t, eq.Equal(nil, list.New(1)))
+	cmptest.AssertEq(t, eq, list.DeriveArbitrary(arbitrary.Int[int]()), list.DeriveDebug(debug.DeriveDebug[int]()))
+}
+
+func TestDeriveOrd(t *testing.T) {
+	ord := list.DeriveOrd(cmp.DeriveOrd[int]())
+	assert.Equal(t, ord.Compare(nil, nil), cmp.EQ)
+	assert.Equal(t, ord.Compare(list.New(1, 2), list.New(1, 2)), cmp.EQ)
+	assert.Equal(t, ord.Compare(list.New(1, 2), list.New(1, 3)), cmp.LT)
+	assert.Equal(t, ord.Compare(list.New(2), list.New(1, 3)), cmp.GT)
+	assert.Equal(t, ord.Compare(list.New(1, 2), list.New(1)), cmp.GT)
+	assert.Equal(t, ord.Compare(nil, list.New(1)), cmp.LT)
+	cmptest.AssertOrd(t, ord, list.DeriveArbitrary(arbitrary.Int[int]()), list.DeriveDebug(debug.DeriveDebug[int]()))
+}
+
+func TestDeriveHash(t *testing.T) {
+	h := list.DeriveHash(hash.DeriveHash[int]())
+	assert.True(t, h.Equal(list.New(1, 2), list.New(1, 2)))
+	assert.False(t, h.Equal(list.New(1, 2), list.New(2, 1)))
+	assert.Equal(t, h.Hash(nil), h.Hash(list.New[int]()))
+	assert.Equal(t, h.Hash(list.New(1, 2)), h.Hash(list.New(1, 2)))
+	assert.NotEqual(t, h.Hash(list.New(1, 2)), h.Hash(list.New(2, 1)))
+	assert.NotEqual(t, h.Hash(list.New(1)), h.Hash(list.New(1, 1)))
+}
+
+func TestSlicing(t *testing.T) {
+	xs := list.New(1, 2, 3, 4, 5)
+	assert.Equal(t, list.Take(xs, 2), list.New(1, 2))
+	assert.Equal(t, list.Drop(xs, 2), list.New(3, 4, 5))
+	assert.Equal(t, list.Drop(xs, 5), list.New[int]())
+	assert.Equal(t, list.StepBy(xs, 2), list.New(1, 3, 5))
+
+	x, ok := list.Nth(xs, 1)
+	assert.True(t, ok)
+	assert.Equal(t, x, 2)
+
+	x, ok = list.Last(xs)
+	assert.True(t, ok)
+	assert.Equal(t, x, 5)
+	assert.Equal(t, list.Count(xs), 5)
+}
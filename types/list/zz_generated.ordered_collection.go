@@ -7,6 +7,7 @@ import (
 	"github.com/genkami/dogs/classes/cmp"
 	"github.com/genkami/dogs/types/iterator"
 	"github.com/genkami/dogs/types/pair"
+	"iter"
 )
 
 // Some packages are unused depending on -include CLI option.
@@ -15,6 +16,17 @@ var _ = (algebra.Monoid[int])(nil)
 var _ = (cmp.Ord[int])(nil)
 var _ = (iterator.Iterator[int])(nil)
 var _ = (*pair.Pair[int, int])(nil)
+var _ = (iter.Seq[int])(nil)
+
+// Drop returns a collection of elements in xs except for the first n elements.
+func Drop[T any](xs *List[T], n int) *List[T] {
+	return FromIterator[T](iterator.Drop[T](xs.Iter(), n))
+}
+
+// DropWhile returns a collection of elements in xs except for the longest prefix whose elements satisfy the given predicate fn.
+func DropWhile[T any](xs *List[T], fn func(T) bool) *List[T] {
+	return FromIterator[T](iterator.DropWhile[T](xs.Iter(), fn))
+}
 
 // FindElemIndex returns a first index of an element in xs that equals to e in the sense of given Eq.
 // It returns negative value if no elements are found.
@@ -30,6 +42,34 @@ func FindIndex[T any](xs *List[T], fn func(T) bool) int {
 	return iterator.FindIndex[T](xs.Iter(), fn)
 }
 
+// Last returns the last element in xs.
+// It returns false as a second return value if xs is empty.
+func Last[T any](xs *List[T]) (T, bool) {
+	return iterator.Last[T](xs.Iter())
+}
+
+// Nth returns the n-th element (0-indexed) in xs.
+// It returns false as a second return value if xs has n or fewer elements, or if n is negative.
+func Nth[T any](xs *List[T], n int) (T, bool) {
+	return iterator.Nth[T](xs.Iter(), n)
+}
+
+// StepBy returns a collection of the first element in xs and then every n-th element after that.
+// It panics if n is not positive.
+func StepBy[T any](xs *List[T], n int) *List[T] {
+	return FromIterator[T](iterator.StepBy[T](xs.Iter(), n))
+}
+
+// Take returns a collection of the first n elements in xs.
+func Take[T any](xs *List[T], n int) *List[T] {
+	return FromIterator[T](iterator.Take[T](xs.Iter(), n))
+}
+
+// TakeWhile returns a collection of the longest prefix of xs whose elements satisfy the given predicate fn.
+func TakeWhile[T any](xs *List[T], fn func(T) bool) *List[T] {
+	return FromIterator[T](iterator.TakeWhile[T](xs.Iter(), fn))
+}
+
 // Zip combines two collections into one that contains pairs of corresponding elements.
 func Zip[T, U any](a *List[T], b *List[U]) *List[pair.Pair[T, U]] {
 	return FromIterator[pair.Pair[T, U]](iterator.Zip(a.Iter(), b.Iter()))
@@ -1,12 +1,242 @@
 package maps
 
-// TODO: FromIterator[K, V](it Iterator[Pair[K, V]] map[K]V
-// TODO: IterKeys[K, V](m map[K]V) Iterator[K]
-// TODO: IterValues[K, V](m map[K]V) Iterator[V]
-// TODO: IterKeyValues[K, V](m map[K]V) Iterator[Pair[K, V]]
-// TODO: Keys[K, V](m map[K]V) []K
-// TODO: Values[K, V](m map[K]V) []V
-// TODO: KeyValues[K, V](m map[K]V) []Pair[K, V]
-// TODO: Map[K, V, L, W](m map[K]V, fn func(K, V) (L, W)) map[L]W
-// TODO: MapKeys[K, V, L](m map[K]V, fn func(K, V) L) map[L]V
-// TODO: MapValues[K, V, W](m map[K]V, fn func(K, V) W) map[K]W
+import (
+	"github.com/genkami/dogs/classes/algebra"
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/types/iterator"
+	"github.com/genkami/dogs/types/pair"
+	"sort"
+	"strings"
+)
+
+// FromIterator builds a map from given Iterator of key-value pairs.
+// If the same key appears more than once, the last one wins.
+func FromIterator[K comparable, V any](it iterator.Iterator[pair.Pair[K, V]]) map[K]V {
+	return iterator.Fold[map[K]V, pair.Pair[K, V]](
+		map[K]V{},
+		it,
+		func(m map[K]V, p pair.Pair[K, V]) map[K]V {
+			m[p.First] = p.Second
+			return m
+		},
+	)
+}
+
+// IterKeyValues returns an Iterator that iterates over key-value pairs in m.
+// The order of elements is unspecified.
+func IterKeyValues[K comparable, V any](m map[K]V) iterator.Iterator[pair.Pair[K, V]] {
+	kvs := make([]pair.Pair[K, V], 0, len(m))
+	for k, v := range m {
+		kvs = append(kvs, pair.Pair[K, V]{First: k, Second: v})
+	}
+	return iterator.Unfold[int, pair.Pair[K, V]](0, func(i int) (int, pair.Pair[K, V], bool) {
+		if len(kvs) <= i {
+			return 0, pair.Pair[K, V]{}, false
+		}
+		return i + 1, kvs[i], true
+	})
+}
+
+// IterKeys returns an Iterator that iterates over keys in m.
+// The order of elements is unspecified.
+func IterKeys[K comparable, V any](m map[K]V) iterator.Iterator[K] {
+	return iterator.Map(IterKeyValues(m), func(p pair.Pair[K, V]) K {
+		return p.First
+	})
+}
+
+// IterValues returns an Iterator that iterates over values in m.
+// The order of elements is unspecified.
+func IterValues[K comparable, V any](m map[K]V) iterator.Iterator[V] {
+	return iterator.Map(IterKeyValues(m), func(p pair.Pair[K, V]) V {
+		return p.Second
+	})
+}
+
+// KeyValues returns a slice of key-value pairs in m.
+// The order of elements is unspecified.
+func KeyValues[K comparable, V any](m map[K]V) []pair.Pair[K, V] {
+	return toSlice(len(m), IterKeyValues(m))
+}
+
+// Keys returns a slice of keys in m.
+// The order of elements is unspecified.
+func Keys[K comparable, V any](m map[K]V) []K {
+	return toSlice(len(m), IterKeys(m))
+}
+
+// Values returns a slice of values in m.
+// The order of elements is unspecified.
+func Values[K comparable, V any](m map[K]V) []V {
+	return toSlice(len(m), IterValues(m))
+}
+
+func toSlice[T any](size int, it iterator.Iterator[T]) []T {
+	return iterator.Fold[[]T, T](
+		make([]T, 0, size),
+		it,
+		func(xs []T, x T) []T { return append(xs, x) },
+	)
+}
+
+// Map returns a map whose entries are results of applying fn to each entry in m.
+// If fn returns the same key more than once, which one wins is unspecified.
+func Map[K comparable, V any, L comparable, W any](m map[K]V, fn func(K, V) (L, W)) map[L]W {
+	return FromIterator(iterator.Map(IterKeyValues(m), func(p pair.Pair[K, V]) pair.Pair[L, W] {
+		l, w := fn(p.First, p.Second)
+		return pair.Pair[L, W]{First: l, Second: w}
+	}))
+}
+
+// MapKeys returns a map whose keys are results of applying fn to each entry in m.
+// If fn returns the same key more than once, which one wins is unspecified.
+func MapKeys[K comparable, V any, L comparable](m map[K]V, fn func(K, V) L) map[L]V {
+	return Map(m, func(k K, v V) (L, V) {
+		return fn(k, v), v
+	})
+}
+
+// MapValues returns a map whose values are results of applying fn to each entry in m.
+func MapValues[K comparable, V, W any](m map[K]V, fn func(K, V) W) map[K]W {
+	return Map(m, func(k K, v V) (K, W) {
+		return k, fn(k, v)
+	})
+}
+
+// Filter returns a map that only contains entries that satisfies given predicate.
+func Filter[K comparable, V any](m map[K]V, fn func(K, V) bool) map[K]V {
+	return FromIterator(iterator.Filter(IterKeyValues(m), func(p pair.Pair[K, V]) bool {
+		return fn(p.First, p.Second)
+	}))
+}
+
+// Fold accumulates every entry in m by applying fn.
+// The order of entries is unspecified.
+func Fold[T any, K comparable, V any](init T, m map[K]V, fn func(T, K, V) T) T {
+	return iterator.Fold(init, IterKeyValues(m), func(acc T, p pair.Pair[K, V]) T {
+		return fn(acc, p.First, p.Second)
+	})
+}
+
+// InsertWith inserts v into m with key k.
+// If m already has a value w with key k, it is replaced with s.Combine(w, v).
+func InsertWith[K comparable, V any](s algebra.Semigroup[V]) func(m map[K]V, k K, v V) {
+	return func(m map[K]V, k K, v V) {
+		if w, ok := m[k]; ok {
+			v = s.Combine(w, v)
+		}
+		m[k] = v
+	}
+}
+
+// FromIteratorWith builds a map from given Iterator of key-value pairs.
+// If the same key appears more than once, the values are combined using s in the order they appear.
+func FromIteratorWith[K comparable, V any](s algebra.Semigroup[V]) func(it iterator.Iterator[pair.Pair[K, V]]) map[K]V {
+	insert := InsertWith[K, V](s)
+	return func(it iterator.Iterator[pair.Pair[K, V]]) map[K]V {
+		m := map[K]V{}
+		iterator.ForEach(it, func(p pair.Pair[K, V]) {
+			insert(m, p.First, p.Second)
+		})
+		return m
+	}
+}
+
+// MergeWith returns a new map that has all entries in both m and n.
+// If both m and n have the same key, their values are combined using s.
+// Neither m nor n is modified.
+func MergeWith[K comparable, V any](s algebra.Semigroup[V]) func(m, n map[K]V) map[K]V {
+	insert := InsertWith[K, V](s)
+	return func(m, n map[K]V) map[K]V {
+		merged := make(map[K]V, len(m))
+		for k, v := range m {
+			merged[k] = v
+		}
+		for k, v := range n {
+			insert(merged, k, v)
+		}
+		return merged
+	}
+}
+
+// DeriveSemigroup derives Semigroup[map[K]V] from Semigroup[V].
+// Its Combine unions two maps and combines colliding values using s.
+func DeriveSemigroup[K comparable, V any](s algebra.Semigroup[V]) algebra.Semigroup[map[K]V] {
+	return &algebra.DefaultSemigroup[map[K]V]{
+		CombineImpl: MergeWith[K, V](s),
+	}
+}
+
+// DeriveMonoid derives Monoid[map[K]V] from Semigroup[V].
+// V doesn't need to be Monoid since the empty map is the identity element of the monoid.
+func DeriveMonoid[K comparable, V any](s algebra.Semigroup[V]) algebra.Monoid[map[K]V] {
+	return &algebra.DefaultMonoid[map[K]V]{
+		Semigroup: DeriveSemigroup[K, V](s),
+		EmptyImpl: func() map[K]V {
+			return map[K]V{}
+		},
+	}
+}
+
+// DeriveEq derives Eq[map[K]V] from Eq[V].
+// Two maps are equal if they have the same set of keys and the values for each key are equal in terms of eq.
+func DeriveEq[K comparable, V any](eq cmp.Eq[V]) cmp.Eq[map[K]V] {
+	return &cmp.DefaultEq[map[K]V]{
+		EqualImpl: func(m, n map[K]V) bool {
+			if len(m) != len(n) {
+				return false
+			}
+			for k, v := range m {
+				w, ok := n[k]
+				if !ok || !eq.Equal(v, w) {
+					return false
+				}
+			}
+			return true
+		},
+	}
+}
+
+// DeriveDebug derives Debug[map[K]V] from Debug[K] and Debug[V].
+// It formats values as `Map{<key>: <value>, ...}`, where entries are sorted by formatted representations of their keys.
+func DeriveDebug[K comparable, V any](dk debug.Debug[K], dv debug.Debug[V]) debug.Debug[map[K]V] {
+	return &debug.DefaultDebug[map[K]V]{
+		DebugFmtImpl: func(m map[K]V) string {
+			entries := make([]pair.Pair[string, string], 0, len(m))
+			for k, v := range m {
+				entries = append(entries, pair.Pair[string, string]{First: dk.DebugFmt(k), Second: dv.DebugFmt(v)})
+			}
+			sort.Slice(entries, func(i, j int) bool {
+				return entries[i].First < entries[j].First
+			})
+			strs := make([]string, 0, len(entries))
+			for _, e := range entries {
+				strs = append(strs, e.First+": "+e.Second)
+			}
+			return "Map{" + strings.Join(strs, ", ") + "}"
+		},
+	}
+}
+
+// DeriveArbitrary derives Arbitrary[map[K]V] from Arbitrary[K], Arbitrary[V] and Ord[K].
+// Entries are sorted by their keys with respect to ord before shrinking so that shrinking is deterministic.
+func DeriveArbitrary[K comparable, V any](ak arbitrary.Arbitrary[K], av arbitrary.Arbitrary[V], ord cmp.Ord[K]) arbitrary.Arbitrary[map[K]V] {
+	return arbitrary.Convert(arbitrary.SliceOf(pair.DerivePairArbitrary(ak, av)),
+		func(kvs []pair.Pair[K, V]) map[K]V {
+			m := make(map[K]V, len(kvs))
+			for _, kv := range kvs {
+				m[kv.First] = kv.Second
+			}
+			return m
+		},
+		func(m map[K]V) []pair.Pair[K, V] {
+			kvs := KeyValues(m)
+			sort.Slice(kvs, func(i, j int) bool {
+				return ord.Lt(kvs[i].First, kvs[j].First)
+			})
+			return kvs
+		},
+	)
+}
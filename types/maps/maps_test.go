@@ -0,0 +1,201 @@
+package maps_test
+
+import (
+	"fmt"
+	"github.com/genkami/dogs/classes/algebra"
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/cmp/cmptest"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/types/iterator"
+	"github.com/genkami/dogs/types/maps"
+	"github.com/genkami/dogs/types/pair"
+	"github.com/genkami/dogs/types/slice"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+)
+
+type Pair = pair.Pair[string, int]
+
+func TestFromIterator(t *testing.T) {
+	subject := func(kvs ...Pair) map[string]int {
+		return maps.FromIterator(slice.Slice[Pair](kvs).Iter())
+	}
+
+	assert.Equal(t, subject(), map[string]int{})
+	assert.Equal(t, subject(Pair{First: "a", Second: 1}), map[string]int{"a": 1})
+	assert.Equal(t,
+		subject(Pair{First: "a", Second: 1}, Pair{First: "b", Second: 2}, Pair{First: "a", Second: 3}),
+		map[string]int{"a": 3, "b": 2},
+	)
+}
+
+func TestIterKeyValues(t *testing.T) {
+	subject := func(m map[string]int) []Pair {
+		return slice.FromIterator(maps.IterKeyValues(m))
+	}
+
+	assert.ElementsMatch(t, subject(map[string]int{}), []Pair{})
+	assert.ElementsMatch(t,
+		subject(map[string]int{"a": 1, "b": 2}),
+		[]Pair{{First: "a", Second: 1}, {First: "b", Second: 2}},
+	)
+}
+
+func TestIterKeys(t *testing.T) {
+	subject := func(m map[string]int) []string {
+		return slice.FromIterator(maps.IterKeys(m))
+	}
+
+	assert.ElementsMatch(t, subject(map[string]int{}), []string{})
+	assert.ElementsMatch(t, subject(map[string]int{"a": 1, "b": 2}), []string{"a", "b"})
+}
+
+func TestIterValues(t *testing.T) {
+	subject := func(m map[string]int) []int {
+		return slice.FromIterator(maps.IterValues(m))
+	}
+
+	assert.ElementsMatch(t, subject(map[string]int{}), []int{})
+	assert.ElementsMatch(t, subject(map[string]int{"a": 1, "b": 2}), []int{1, 2})
+}
+
+func TestKeyValues(t *testing.T) {
+	assert.ElementsMatch(t, maps.KeyValues(map[string]int{}), []Pair{})
+	assert.ElementsMatch(t,
+		maps.KeyValues(map[string]int{"a": 1, "b": 2}),
+		[]Pair{{First: "a", Second: 1}, {First: "b", Second: 2}},
+	)
+}
+
+func TestKeys(t *testing.T) {
+	assert.ElementsMatch(t, maps.Keys(map[string]int{}), []string{})
+	assert.ElementsMatch(t, maps.Keys(map[string]int{"a": 1, "b": 2}), []string{"a", "b"})
+}
+
+func TestValues(t *testing.T) {
+	assert.ElementsMatch(t, maps.Values(map[string]int{}), []int{})
+	assert.ElementsMatch(t, maps.Values(map[string]int{"a": 1, "b": 2}), []int{1, 2})
+}
+
+func TestMap(t *testing.T) {
+	swap := func(k string, v int) (int, string) { return v, k }
+	assert.Equal(t, maps.Map(map[string]int{}, swap), map[int]string{})
+	assert.Equal(t, maps.Map(map[string]int{"a": 1, "b": 2}, swap), map[int]string{1: "a", 2: "b"})
+}
+
+func TestMapKeys(t *testing.T) {
+	fn := func(k string, v int) string { return fmt.Sprint(k, v) }
+	assert.Equal(t, maps.MapKeys(map[string]int{}, fn), map[string]int{})
+	assert.Equal(t, maps.MapKeys(map[string]int{"a": 1, "b": 2}, fn), map[string]int{"a1": 1, "b2": 2})
+}
+
+func TestMapValues(t *testing.T) {
+	fn := func(k string, v int) string { return fmt.Sprint(k, v) }
+	assert.Equal(t, maps.MapValues(map[string]int{}, fn), map[string]string{})
+	assert.Equal(t, maps.MapValues(map[string]int{"a": 1, "b": 2}, fn), map[string]string{"a": "a1", "b": "b2"})
+}
+
+func TestFilter(t *testing.T) {
+	fn := func(k string, v int) bool { return k == "a" || v%2 == 0 }
+	assert.Equal(t, maps.Filter(map[string]int{}, fn), map[string]int{})
+	assert.Equal(t,
+		maps.Filter(map[string]int{"a": 1, "b": 2, "c": 3}, fn),
+		map[string]int{"a": 1, "b": 2},
+	)
+}
+
+func TestFold(t *testing.T) {
+	fn := func(acc int, k string, v int) int { return acc + len(k)*v }
+	assert.Equal(t, maps.Fold(0, map[string]int{}, fn), 0)
+	assert.Equal(t, maps.Fold(1, map[string]int{"a": 2, "bb": 3}, fn), 9)
+}
+
+func TestInsertWith(t *testing.T) {
+	insert := maps.InsertWith[string, int](algebra.DeriveAdditiveSemigroup[int]())
+	m := map[string]int{}
+
+	insert(m, "a", 1)
+	assert.Equal(t, m, map[string]int{"a": 1})
+	insert(m, "b", 2)
+	assert.Equal(t, m, map[string]int{"a": 1, "b": 2})
+	insert(m, "a", 3)
+	assert.Equal(t, m, map[string]int{"a": 4, "b": 2})
+}
+
+func TestFromIteratorWith(t *testing.T) {
+	subject := func(kvs ...pair.Pair[string, string]) map[string]string {
+		s := algebra.DeriveAdditiveSemigroup[string]()
+		return maps.FromIteratorWith[string, string](s)(slice.Slice[pair.Pair[string, string]](kvs).Iter())
+	}
+	kv := func(k, v string) pair.Pair[string, string] {
+		return pair.Pair[string, string]{First: k, Second: v}
+	}
+
+	assert.Equal(t, subject(), map[string]string{})
+	assert.Equal(t, subject(kv("a", "x")), map[string]string{"a": "x"})
+	assert.Equal(t,
+		subject(kv("a", "x"), kv("b", "y"), kv("a", "z"), kv("a", "w")),
+		map[string]string{"a": "xzw", "b": "y"},
+	)
+}
+
+func TestMergeWith(t *testing.T) {
+	merge := maps.MergeWith[string, string](algebra.DeriveAdditiveSemigroup[string]())
+	m := map[string]string{"a": "x", "b": "y"}
+	n := map[string]string{"b": "z", "c": "w"}
+
+	assert.Equal(t, merge(m, n), map[string]string{"a": "x", "b": "yz", "c": "w"})
+	assert.Equal(t, merge(n, m), map[string]string{"a": "x", "b": "zy", "c": "w"})
+	assert.Equal(t, m, map[string]string{"a": "x", "b": "y"}, "m should not be modified")
+	assert.Equal(t, n, map[string]string{"b": "z", "c": "w"}, "n should not be modified")
+}
+
+func TestDeriveMonoid(t *testing.T) {
+	m := maps.DeriveMonoid[string, int](algebra.DeriveAdditiveSemigroup[int]())
+
+	assert.Equal(t, m.Combine(map[string]int{"a": 1}, map[string]int{"a": 2, "b": 3}), map[string]int{"a": 3, "b": 3})
+	assert.Equal(t, m.Combine(m.Empty(), map[string]int{"a": 1}), map[string]int{"a": 1})
+	assert.Equal(t, m.Combine(map[string]int{"a": 1}, m.Empty()), map[string]int{"a": 1})
+
+	counts := iterator.Sum(m)(iterator.Map(
+		slice.Slice[string]{"a", "b", "a", "c", "a"}.Iter(),
+		func(k string) map[string]int { return map[string]int{k: 1} },
+	))
+	assert.Equal(t, counts, map[string]int{"a": 3, "b": 1, "c": 1})
+}
+
+func TestDeriveDebug(t *testing.T) {
+	d := maps.DeriveDebug(debug.DeriveDebug[string](), debug.DeriveDebug[int]())
+	assert.Equal(t, d.DebugFmt(map[string]int{}), "Map{}")
+	assert.Equal(t, d.DebugFmt(map[string]int{"a": 1}), `Map{"a": 1}`)
+	assert.Equal(t, d.DebugFmt(map[string]int{"b": 2, "c": 3, "a": 1}), `Map{"a": 1, "b": 2, "c": 3}`)
+}
+
+func TestDeriveArbitrary(t *testing.T) {
+	a := maps.DeriveArbitrary(arbitrary.String(), arbitrary.Int[int](), cmp.DeriveOrd[string]())
+	choose := func() map[string]int {
+		return a.Choose(rand.New(rand.NewSource(42)))
+	}
+	assert.Equal(t, choose(), choose())
+	assert.Empty(t, a.Shrink(map[string]int{}))
+	assert.Equal(t, a.Shrink(map[string]int{"x": 1}), []map[string]int{{}, {"": 1}, {"a": 1}, {"x": 0}})
+	m := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4, "e": 5}
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, a.Shrink(m), a.Shrink(m), "shrinking should be deterministic")
+	}
+}
+
+func TestDeriveEq(t *testing.T) {
+	eq := maps.DeriveEq[string](cmp.DeriveEq[int]())
+	assert.True(t, eq.Equal(nil, map[string]int{}))
+	assert.True(t, eq.Equal(map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2, "a": 1}))
+	assert.False(t, eq.Equal(map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "b": 3}))
+	assert.False(t, eq.Equal(map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "c": 2}))
+	assert.False(t, eq.Equal(map[string]int{"a": 1}, map[string]int{"a": 1, "b": 2}))
+	cmptest.AssertEq(t, eq,
+		maps.DeriveArbitrary(arbitrary.String(), arbitrary.Int[int](), cmp.DeriveOrd[string]()),
+		maps.DeriveDebug(debug.DeriveDebug[string](), debug.DeriveDebug[int]()),
+	)
+}
@@ -2,7 +2,13 @@ package option
 
 import (
 	"github.com/genkami/dogs/classes/algebra"
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/classes/hash"
 	"github.com/genkami/dogs/types/iterator"
+	"github.com/genkami/dogs/types/pair"
+	"math/rand"
 )
 
 // Option is an optional value.
@@ -115,8 +121,85 @@ func (it *optionIterator[T]) Next() (T, bool) {
 // TODO: func MapOrElse[T, U any](x Option[T], fn func(T) U, default func() U) U
 // TODO: func Switch[T any](x Option[T], ifSome func(T) ifNone func())
 
-// TODO: func DeriveEq[T any](eq cmp.Eq[T]) cmp.Eq[Option[T]]
-// TODO: func DeriveOrd[T any](ord cmp.Ord[T]) cmp.Ord[Option[T]]
+// ZipLongest combines two Iterators into one that yields pairs of corresponding elements.
+// Unlike iterator.Zip, it continues until both of them run out of elements,
+// and the missing elements of the shorter one are filled with None().
+// Once either of them returns no value, its Next is never called again.
+func ZipLongest[T, U any](a iterator.Iterator[T], b iterator.Iterator[U]) iterator.Iterator[pair.Pair[Option[T], Option[U]]] {
+	return &zipLongestIterator[T, U]{
+		a: a,
+		b: b,
+	}
+}
+
+type zipLongestIterator[T, U any] struct {
+	a         iterator.Iterator[T]
+	b         iterator.Iterator[U]
+	aFinished bool
+	bFinished bool
+}
+
+func (it *zipLongestIterator[T, U]) Next() (pair.Pair[Option[T], Option[U]], bool) {
+	var x Option[T]
+	var y Option[U]
+	if !it.aFinished {
+		x = FromIterator(it.a)
+		it.aFinished = !x.some
+	}
+	if !it.bFinished {
+		y = FromIterator(it.b)
+		it.bFinished = !y.some
+	}
+	if !x.some && !y.some {
+		return pair.Pair[Option[T], Option[U]]{}, false
+	}
+	return pair.Pair[Option[T], Option[U]]{First: x, Second: y}, true
+}
+
+// DeriveEq derives Eq[Option[T]] from Eq[T].
+// Two values are equal if both of them are None() or both have values that are equal in terms of eq.
+func DeriveEq[T any](eq cmp.Eq[T]) cmp.Eq[Option[T]] {
+	return &cmp.DefaultEq[Option[T]]{
+		EqualImpl: func(x, y Option[T]) bool {
+			if x.some && y.some {
+				return eq.Equal(x.v, y.v)
+			}
+			return x.some == y.some
+		},
+	}
+}
+
+// DeriveOrd derives Ord[Option[T]] from Ord[T].
+// None() is less than any Some(x), and Some(x) and Some(y) are compared by ord.
+func DeriveOrd[T any](ord cmp.Ord[T]) cmp.Ord[Option[T]] {
+	return &cmp.DefaultOrd[Option[T]]{
+		CompareImpl: func(x, y Option[T]) cmp.Ordering {
+			if x.some && y.some {
+				return ord.Compare(x.v, y.v)
+			} else if x.some {
+				return cmp.GT
+			} else if y.some {
+				return cmp.LT
+			} else {
+				return cmp.EQ
+			}
+		},
+	}
+}
+
+// DeriveHash derives Hash[Option[T]] from Hash[T].
+func DeriveHash[T any](h hash.Hash[T]) hash.Hash[Option[T]] {
+	var eq cmp.Eq[T] = h
+	return &hash.DefaultHash[Option[T]]{
+		Eq: DeriveEq(eq),
+		HashImpl: func(x Option[T]) uint64 {
+			if !x.some {
+				return hash.Empty
+			}
+			return hash.Combine(hash.Empty, h.Hash(x.v))
+		},
+	}
+}
 
 // DeriveSemigroup derives Semigroup[Option[T]] from Semigroup[T]
 func DeriveSemigroup[T any](s algebra.Semigroup[T]) algebra.Semigroup[Option[T]] {
@@ -144,3 +227,49 @@ func DeriveMonoid[T any](s algebra.Semigroup[T]) algebra.Monoid[Option[T]] {
 		},
 	}
 }
+
+// DeriveFirstMonoid derives Monoid[Option[T]] whose Combine returns the first argument that has a value.
+func DeriveFirstMonoid[T any]() algebra.Monoid[Option[T]] {
+	return DeriveMonoid(algebra.DeriveFirstSemigroup[T]())
+}
+
+// DeriveLastMonoid derives Monoid[Option[T]] whose Combine returns the last argument that has a value.
+func DeriveLastMonoid[T any]() algebra.Monoid[Option[T]] {
+	return DeriveMonoid(algebra.DeriveLastSemigroup[T]())
+}
+
+// DeriveDebug derives Debug[Option[T]] from Debug[T].
+// It formats values as `Some(<value>)` or `None`.
+func DeriveDebug[T any](d debug.Debug[T]) debug.Debug[Option[T]] {
+	return &debug.DefaultDebug[Option[T]]{
+		DebugFmtImpl: func(x Option[T]) string {
+			if !IsSome(x) {
+				return "None"
+			}
+			return "Some(" + d.DebugFmt(Unwrap(x)) + ")"
+		},
+	}
+}
+
+// DeriveArbitrary derives Arbitrary[Option[T]] from Arbitrary[T].
+// It sometimes generates None(), and shrinks Some(x) to None() and then to Some(<shrunk x>).
+func DeriveArbitrary[T any](a arbitrary.Arbitrary[T]) arbitrary.Arbitrary[Option[T]] {
+	return &arbitrary.DefaultArbitrary[Option[T]]{
+		ChooseImpl: func(r *rand.Rand) Option[T] {
+			if r.Intn(4) == 0 {
+				return None[T]()
+			}
+			return Some(a.Choose(r))
+		},
+		ShrinkImpl: func(x Option[T]) []Option[T] {
+			if !IsSome(x) {
+				return nil
+			}
+			candidates := []Option[T]{None[T]()}
+			for _, y := range a.Shrink(Unwrap(x)) {
+				candidates = append(candidates, Some(y))
+			}
+			return candidates
+		},
+	}
+}
@@ -2,9 +2,18 @@ package option_test
 
 import (
 	"github.com/genkami/dogs/classes/algebra"
+	"github.com/genkami/dogs/classes/algebra/algebratest"
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/cmp/cmptest"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/classes/hash"
 	"github.com/genkami/dogs/types/option"
+	"github.com/genkami/dogs/types/pair"
 	"github.com/genkami/dogs/types/slice"
 	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"slices"
 	"testing"
 )
 
@@ -68,6 +77,59 @@ func TestIter(t *testing.T) {
 	assert.Equal(t, some(3), []int{3})
 }
 
+func TestZipLongest(t *testing.T) {
+	type Pair = pair.Pair[option.Option[int], option.Option[string]]
+	subject := func(xs []int, ys []string) []Pair {
+		zipped := option.ZipLongest(slice.Slice[int](xs).Iter(), slice.Slice[string](ys).Iter())
+		return ([]Pair)(slice.FromIterator(zipped))
+	}
+	p := func(x option.Option[int], y option.Option[string]) Pair {
+		return Pair{First: x, Second: y}
+	}
+
+	assert.Equal(t, subject([]int{}, []string{}), []Pair{})
+	assert.Equal(t, subject([]int{1, 2}, []string{"a", "b"}), []Pair{
+		p(option.Some(1), option.Some("a")),
+		p(option.Some(2), option.Some("b")),
+	})
+	assert.Equal(t, subject([]int{1, 2, 3}, []string{"a"}), []Pair{
+		p(option.Some(1), option.Some("a")),
+		p(option.Some(2), option.None[string]()),
+		p(option.Some(3), option.None[string]()),
+	})
+	assert.Equal(t, subject([]int{}, []string{"a", "b"}), []Pair{
+		p(option.None[int](), option.Some("a")),
+		p(option.None[int](), option.Some("b")),
+	})
+}
+
+// flakyIterator returns false once after each element, and then continues.
+type flakyIterator struct {
+	xs      []int
+	stalled bool
+}
+
+func (it *flakyIterator) Next() (int, bool) {
+	if it.stalled || len(it.xs) == 0 {
+		it.stalled = false
+		return 0, false
+	}
+	x := it.xs[0]
+	it.xs = it.xs[1:]
+	it.stalled = true
+	return x, true
+}
+
+func TestZipLongest_stopsAtFirstExhaustion(t *testing.T) {
+	type Pair = pair.Pair[option.Option[int], option.Option[string]]
+	zipped := option.ZipLongest[int, string](&flakyIterator{xs: []int{1, 2}}, slice.Slice[string]{"a", "b", "c"}.Iter())
+	assert.Equal(t, ([]Pair)(slice.FromIterator(zipped)), []Pair{
+		{First: option.Some(1), Second: option.Some("a")},
+		{First: option.None[int](), Second: option.Some("b")},
+		{First: option.None[int](), Second: option.Some("c")},
+	})
+}
+
 func TestDeriveSemigroup(t *testing.T) {
 	some := func(x int) option.Option[int] {
 		return option.Some[int](x)
@@ -112,3 +174,84 @@ func TestDeriveMonoid(t *testing.T) {
 	assert.True(t, option.Equal(subject(some(123), optMono.Empty()), some(123)))
 	assert.True(t, option.Equal(subject(optMono.Empty(), some(456)), some(456)))
 }
+
+func TestAll(t *testing.T) {
+	assert.Equal(t, slices.Collect(option.Some[int](1).All()), []int{1})
+	assert.Equal(t, slices.Collect(option.None[int]().All()), []int(nil))
+}
+
+func TestDeriveDebug(t *testing.T) {
+	d := option.DeriveDebug[string](debug.DeriveDebug[string]())
+	assert.Equal(t, d.DebugFmt(option.Some[string]("hoge")), `Some("hoge")`)
+	assert.Equal(t, d.DebugFmt(option.None[string]()), "None")
+}
+
+func TestDeriveArbitrary(t *testing.T) {
+	a := option.DeriveArbitrary(arbitrary.Int[int]())
+
+	r := rand.New(rand.NewSource(42))
+	numSome := 0
+	for i := 0; i < 100; i++ {
+		if option.IsSome(a.Choose(r)) {
+			numSome++
+		}
+	}
+	assert.True(t, 0 < numSome && numSome < 100)
+
+	assert.Empty(t, a.Shrink(option.None[int]()))
+	assert.Equal(t, a.Shrink(option.Some[int](2)), []option.Option[int]{option.None[int](), option.Some[int](0), option.Some[int](1)})
+}
+
+func TestDeriveEq(t *testing.T) {
+	eq := option.DeriveEq(cmp.DeriveEq[int]())
+	assert.True(t, eq.Equal(option.None[int](), option.None[int]()))
+	assert.True(t, eq.Equal(option.Some(1), option.Some(1)))
+	assert.False(t, eq.Equal(option.Some(1), option.Some(2)))
+	assert.False(t, eq.Equal(option.Some(1), option.None[int]()))
+	assert.False(t, eq.Equal(option.None[int](), option.Some(1)))
+	cmptest.AssertEq(t, eq, option.DeriveArbitrary(arbitrary.Int[int]()), option.DeriveDebug(debug.DeriveDebug[int]()))
+}
+
+func TestDeriveOrd(t *testing.T) {
+	ord := option.DeriveOrd(cmp.DeriveOrd[int]())
+	assert.Equal(t, ord.Compare(option.None[int](), option.None[int]()), cmp.EQ)
+	assert.Equal(t, ord.Compare(option.None[int](), option.Some(1)), cmp.LT)
+	assert.Equal(t, ord.Compare(option.Some(1), option.None[int]()), cmp.GT)
+	assert.Equal(t, ord.Compare(option.Some(1), option.Some(2)), cmp.LT)
+	assert.Equal(t, ord.Compare(option.Some(2), option.Some(2)), cmp.EQ)
+	cmptest.AssertOrd(t, ord, option.DeriveArbitrary(arbitrary.Int[int]()), option.DeriveDebug(debug.DeriveDebug[int]()))
+}
+
+func TestDeriveFirstMonoid(t *testing.T) {
+	m := option.DeriveFirstMonoid[int]()
+	assert.Equal(t, m.Combine(option.Some(1), option.Some(2)), option.Some(1))
+	assert.Equal(t, m.Combine(option.None[int](), option.Some(2)), option.Some(2))
+	assert.Equal(t, m.Combine(option.Some(1), option.None[int]()), option.Some(1))
+	algebratest.AssertMonoid(t, m,
+		option.DeriveEq(cmp.DeriveEq[int]()),
+		option.DeriveArbitrary(arbitrary.Int[int]()),
+		option.DeriveDebug(debug.DeriveDebug[int]()),
+	)
+}
+
+func TestDeriveLastMonoid(t *testing.T) {
+	m := option.DeriveLastMonoid[int]()
+	assert.Equal(t, m.Combine(option.Some(1), option.Some(2)), option.Some(2))
+	assert.Equal(t, m.Combine(option.None[int](), option.Some(2)), option.Some(2))
+	assert.Equal(t, m.Combine(option.Some(1), option.None[int]()), option.Some(1))
+	algebratest.AssertMonoid(t, m,
+		option.DeriveEq(cmp.DeriveEq[int]()),
+		option.DeriveArbitrary(arbitrary.Int[int]()),
+		option.DeriveDebug(debug.DeriveDebug[int]()),
+	)
+}
+
+func TestDeriveHash(t *testing.T) {
+	h := option.DeriveHash(hash.DeriveHash[int]())
+	assert.True(t, h.Equal(option.Some(1), option.Some(1)))
+	assert.False(t, h.Equal(option.Some(1), option.None[int]()))
+	assert.Equal(t, h.Hash(option.Some(1)), h.Hash(option.Some(1)))
+	assert.Equal(t, h.Hash(option.None[int]()), h.Hash(option.None[int]()))
+	assert.NotEqual(t, h.Hash(option.Some(1)), h.Hash(option.Some(2)))
+	assert.NotEqual(t, h.Hash(option.Some(1)), h.Hash(option.None[int]()))
+}
@@ -3,7 +3,11 @@ package pair
 import (
 	"fmt"
 	"github.com/genkami/dogs/classes/algebra"
+	"github.com/genkami/dogs/classes/arbitrary"
 	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/classes/hash"
+	"math/rand"
 )
 
 // Pair is a pair of two values.
@@ -126,3 +130,138 @@ func DerivePtrPairMonoid[T, U any](mt algebra.Monoid[T], mu algebra.Monoid[U]) a
 		},
 	}
 }
+
+// DerivePairGroup derives Group[Pair[T, U]] from Group[T] and Group[U].
+func DerivePairGroup[T, U any](gt algebra.Group[T], gu algebra.Group[U]) algebra.Group[Pair[T, U]] {
+	var mt algebra.Monoid[T] = gt
+	var mu algebra.Monoid[U] = gu
+	return &algebra.DefaultGroup[Pair[T, U]]{
+		Monoid: DerivePairMonoid[T, U](mt, mu),
+		InverseImpl: func(p Pair[T, U]) Pair[T, U] {
+			return Pair[T, U]{
+				First:  gt.Inverse(p.First),
+				Second: gu.Inverse(p.Second),
+			}
+		},
+	}
+}
+
+// DerivePairSemiring derives Semiring[Pair[T, U]] from Semiring[T] and Semiring[U].
+// Both operations are applied componentwise.
+func DerivePairSemiring[T, U any](st algebra.Semiring[T], su algebra.Semiring[U]) algebra.Semiring[Pair[T, U]] {
+	return &algebra.DefaultSemiring[Pair[T, U]]{
+		Additive: algebra.DefaultCommutativeMonoid[Pair[T, U]]{
+			Monoid: DerivePairMonoid[T, U](algebra.DeriveSumMonoid(st), algebra.DeriveSumMonoid(su)),
+		},
+		Multiplicative: DerivePairMonoid(algebra.DeriveProductMonoid(st), algebra.DeriveProductMonoid(su)),
+	}
+}
+
+// DerivePairRing derives Ring[Pair[T, U]] from Ring[T] and Ring[U].
+// All operations are applied componentwise.
+func DerivePairRing[T, U any](rt algebra.Ring[T], ru algebra.Ring[U]) algebra.Ring[Pair[T, U]] {
+	return &algebra.DefaultRing[Pair[T, U]]{
+		Additive: algebra.DefaultCommutativeGroup[Pair[T, U]]{
+			Group: DerivePairGroup[T, U](algebra.DeriveSumGroup(rt), algebra.DeriveSumGroup(ru)),
+		},
+		Multiplicative: DerivePairMonoid[T, U](algebra.DeriveProductMonoid[T](rt), algebra.DeriveProductMonoid[U](ru)),
+	}
+}
+
+// DerivePairLattice derives Lattice[Pair[T, U]] from Lattice[T] and Lattice[U].
+// Both operations are applied componentwise.
+func DerivePairLattice[T, U any](lt algebra.Lattice[T], lu algebra.Lattice[U]) algebra.Lattice[Pair[T, U]] {
+	return &algebra.DefaultLattice[Pair[T, U]]{
+		JoinImpl: func(p, q Pair[T, U]) Pair[T, U] {
+			return Pair[T, U]{
+				First:  lt.Join(p.First, q.First),
+				Second: lu.Join(p.Second, q.Second),
+			}
+		},
+		MeetImpl: func(p, q Pair[T, U]) Pair[T, U] {
+			return Pair[T, U]{
+				First:  lt.Meet(p.First, q.First),
+				Second: lu.Meet(p.Second, q.Second),
+			}
+		},
+	}
+}
+
+// DerivePairBoundedLattice derives BoundedLattice[Pair[T, U]] from BoundedLattice[T] and BoundedLattice[U].
+func DerivePairBoundedLattice[T, U any](lt algebra.BoundedLattice[T], lu algebra.BoundedLattice[U]) algebra.BoundedLattice[Pair[T, U]] {
+	var llt algebra.Lattice[T] = lt
+	var llu algebra.Lattice[U] = lu
+	return &algebra.DefaultBoundedLattice[Pair[T, U]]{
+		Lattice: DerivePairLattice[T, U](llt, llu),
+		TopImpl: func() Pair[T, U] {
+			return Pair[T, U]{
+				First:  lt.Top(),
+				Second: lu.Top(),
+			}
+		},
+		BottomImpl: func() Pair[T, U] {
+			return Pair[T, U]{
+				First:  lt.Bottom(),
+				Second: lu.Bottom(),
+			}
+		},
+	}
+}
+
+// DerivePairHash derives Hash[Pair[T, U]] from Hash[T] and Hash[U].
+func DerivePairHash[T, U any](ht hash.Hash[T], hu hash.Hash[U]) hash.Hash[Pair[T, U]] {
+	var et cmp.Eq[T] = ht
+	var eu cmp.Eq[U] = hu
+	return &hash.DefaultHash[Pair[T, U]]{
+		Eq: DerivePairEq[T, U](et, eu),
+		HashImpl: func(p Pair[T, U]) uint64 {
+			return hash.Combine(hash.Combine(hash.Empty, ht.Hash(p.First)), hu.Hash(p.Second))
+		},
+	}
+}
+
+// DerivePairDebug derives Debug[Pair[T, U]] from Debug[T] and Debug[U].
+// It formats values as `Pair(<first>, <second>)`.
+func DerivePairDebug[T, U any](dt debug.Debug[T], du debug.Debug[U]) debug.Debug[Pair[T, U]] {
+	return &debug.DefaultDebug[Pair[T, U]]{
+		DebugFmtImpl: func(p Pair[T, U]) string {
+			return "Pair(" + dt.DebugFmt(p.First) + ", " + du.DebugFmt(p.Second) + ")"
+		},
+	}
+}
+
+// DerivePtrPairDebug derives Debug[*Pair[T, U]] from Debug[T] and Debug[U].
+// It formats values as `&Pair(<first>, <second>)`, or `nil`.
+func DerivePtrPairDebug[T, U any](dt debug.Debug[T], du debug.Debug[U]) debug.Debug[*Pair[T, U]] {
+	d := DerivePairDebug(dt, du)
+	return &debug.DefaultDebug[*Pair[T, U]]{
+		DebugFmtImpl: func(p *Pair[T, U]) string {
+			if p == nil {
+				return "nil"
+			}
+			return "&" + d.DebugFmt(*p)
+		},
+	}
+}
+
+// DerivePairArbitrary derives Arbitrary[Pair[T, U]] from Arbitrary[T] and Arbitrary[U].
+// It shrinks values by shrinking either of their elements.
+func DerivePairArbitrary[T, U any](at arbitrary.Arbitrary[T], au arbitrary.Arbitrary[U]) arbitrary.Arbitrary[Pair[T, U]] {
+	return &arbitrary.DefaultArbitrary[Pair[T, U]]{
+		ChooseImpl: func(r *rand.Rand) Pair[T, U] {
+			first := at.Choose(r)
+			second := au.Choose(r)
+			return Pair[T, U]{First: first, Second: second}
+		},
+		ShrinkImpl: func(p Pair[T, U]) []Pair[T, U] {
+			var candidates []Pair[T, U]
+			for _, x := range at.Shrink(p.First) {
+				candidates = append(candidates, Pair[T, U]{First: x, Second: p.Second})
+			}
+			for _, y := range au.Shrink(p.Second) {
+				candidates = append(candidates, Pair[T, U]{First: p.First, Second: y})
+			}
+			return candidates
+		},
+	}
+}
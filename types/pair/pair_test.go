@@ -2,7 +2,11 @@ package pair_test
 
 import (
 	"github.com/genkami/dogs/classes/algebra"
+	"github.com/genkami/dogs/classes/algebra/algebratest"
+	"github.com/genkami/dogs/classes/arbitrary"
 	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/classes/hash"
 	"github.com/genkami/dogs/types/pair"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -115,3 +119,86 @@ func TestDerivePtrPairMonoid(t *testing.T) {
 	assert.Equal(t, m.Combine(pair(1, "hoge"), pair(2, "fuga")), pair(3, "hogefuga"))
 	assert.Equal(t, m.Combine(m.Empty(), pair(123, "foo")), pair(123, "foo"))
 }
+
+func TestDerivePairDebug(t *testing.T) {
+	d := pair.DerivePairDebug(debug.DeriveDebug[int](), debug.DeriveDebug[string]())
+	assert.Equal(t, d.DebugFmt(pair.Pair[int, string]{1, "hoge"}), `Pair(1, "hoge")`)
+}
+
+func TestDerivePtrPairDebug(t *testing.T) {
+	d := pair.DerivePtrPairDebug(debug.DeriveDebug[int](), debug.DeriveDebug[string]())
+	assert.Equal(t, d.DebugFmt(&pair.Pair[int, string]{1, "hoge"}), `&Pair(1, "hoge")`)
+	assert.Equal(t, d.DebugFmt(nil), "nil")
+}
+
+func TestDerivePairArbitrary(t *testing.T) {
+	type Pair = pair.Pair[int, bool]
+	a := pair.DerivePairArbitrary(arbitrary.Int[int](), arbitrary.Bool())
+	assert.Empty(t, a.Shrink(Pair{0, false}))
+	assert.Equal(t, a.Shrink(Pair{2, true}), []Pair{{0, true}, {1, true}, {2, false}})
+}
+
+func TestDerivePairGroup(t *testing.T) {
+	g := pair.DerivePairGroup(algebra.DeriveAdditiveGroup[int](), algebra.DeriveAdditiveGroup[float64]())
+	assert.Equal(t, g.Inverse(pair.Pair[int, float64]{1, 2.5}), pair.Pair[int, float64]{-1, -2.5})
+	algebratest.AssertGroup(t,
+		pair.DerivePairGroup(algebra.DeriveAdditiveGroup[int](), algebra.DeriveAdditiveGroup[int8]()),
+		pair.DerivePairEq(cmp.DeriveEq[int](), cmp.DeriveEq[int8]()),
+		pair.DerivePairArbitrary(arbitrary.Int[int](), arbitrary.Int[int8]()),
+		pair.DerivePairDebug(debug.DeriveDebug[int](), debug.DeriveDebug[int8]()),
+	)
+}
+
+func TestDerivePairSemiring(t *testing.T) {
+	s := pair.DerivePairSemiring(algebra.DeriveNumericSemiring[int](), algebra.DeriveBoolSemiring())
+	assert.Equal(t, s.Add(pair.Pair[int, bool]{2, false}, pair.Pair[int, bool]{3, true}), pair.Pair[int, bool]{5, true})
+	assert.Equal(t, s.Mul(pair.Pair[int, bool]{2, false}, pair.Pair[int, bool]{3, true}), pair.Pair[int, bool]{6, false})
+	assert.Equal(t, s.Zero(), pair.Pair[int, bool]{0, false})
+	assert.Equal(t, s.One(), pair.Pair[int, bool]{1, true})
+	algebratest.AssertSemiring(t, s,
+		pair.DerivePairEq(cmp.DeriveEq[int](), cmp.DeriveEq[bool]()),
+		pair.DerivePairArbitrary(arbitrary.Int[int](), arbitrary.Bool()),
+		pair.DerivePairDebug(debug.DeriveDebug[int](), debug.DeriveDebug[bool]()),
+	)
+}
+
+func TestDerivePairRing(t *testing.T) {
+	r := pair.DerivePairRing(algebra.DeriveNumericRing[int](), algebra.DeriveNumericRing[int8]())
+	assert.Equal(t, r.Neg(pair.Pair[int, int8]{1, 2}), pair.Pair[int, int8]{-1, -2})
+	algebratest.AssertRing(t, r,
+		pair.DerivePairEq(cmp.DeriveEq[int](), cmp.DeriveEq[int8]()),
+		pair.DerivePairArbitrary(arbitrary.Int[int](), arbitrary.Int[int8]()),
+		pair.DerivePairDebug(debug.DeriveDebug[int](), debug.DeriveDebug[int8]()),
+	)
+}
+
+func TestDerivePairLattice(t *testing.T) {
+	l := pair.DerivePairLattice(algebra.DeriveOrdLattice(cmp.DeriveOrd[int]()), algebra.DeriveOrdLattice(cmp.DeriveOrd[string]()))
+	assert.Equal(t, l.Join(pair.Pair[int, string]{1, "b"}, pair.Pair[int, string]{2, "a"}), pair.Pair[int, string]{2, "b"})
+	assert.Equal(t, l.Meet(pair.Pair[int, string]{1, "b"}, pair.Pair[int, string]{2, "a"}), pair.Pair[int, string]{1, "a"})
+	algebratest.AssertLattice(t, l,
+		pair.DerivePairEq(cmp.DeriveEq[int](), cmp.DeriveEq[string]()),
+		pair.DerivePairArbitrary(arbitrary.Int[int](), arbitrary.String()),
+		pair.DerivePairDebug(debug.DeriveDebug[int](), debug.DeriveDebug[string]()),
+	)
+}
+
+func TestDerivePairBoundedLattice(t *testing.T) {
+	l := pair.DerivePairBoundedLattice(algebra.DeriveBoolLattice(), algebra.DeriveBoolLattice())
+	assert.Equal(t, l.Top(), pair.Pair[bool, bool]{true, true})
+	assert.Equal(t, l.Bottom(), pair.Pair[bool, bool]{false, false})
+	algebratest.AssertBoundedLattice(t, l,
+		pair.DerivePairEq(cmp.DeriveEq[bool](), cmp.DeriveEq[bool]()),
+		pair.DerivePairArbitrary(arbitrary.Bool(), arbitrary.Bool()),
+		pair.DerivePairDebug(debug.DeriveDebug[bool](), debug.DeriveDebug[bool]()),
+	)
+}
+
+func TestDerivePairHash(t *testing.T) {
+	h := pair.DerivePairHash(hash.DeriveHash[int](), hash.DeriveHash[string]())
+	assert.True(t, h.Equal(pair.Pair[int, string]{1, "a"}, pair.Pair[int, string]{1, "a"}))
+	assert.False(t, h.Equal(pair.Pair[int, string]{1, "a"}, pair.Pair[int, string]{1, "b"}))
+	assert.Equal(t, h.Hash(pair.Pair[int, string]{1, "a"}), h.Hash(pair.Pair[int, string]{1, "a"}))
+	assert.NotEqual(t, h.Hash(pair.Pair[int, string]{1, "a"}), h.Hash(pair.Pair[int, string]{1, "b"}))
+	assert.NotEqual(t, h.Hash(pair.Pair[int, string]{1, "a"}), h.Hash(pair.Pair[int, string]{2, "a"}))
+}
@@ -0,0 +1,161 @@
+package result
+
+import (
+	"errors"
+	"github.com/genkami/dogs/types/iterator"
+	"github.com/genkami/dogs/types/option"
+)
+
+// Result is either a successful value or an error.
+type Result[T any] struct {
+	v   T
+	err error
+}
+
+//go:generate go run ../../cmd/gen-functions -template Collection -pkg result -name Result -exclude Filter,Map -out zz_generated.collection.go
+//go:generate go run ../../cmd/gen-functions -template Monad -pkg result -name Result -out zz_generated.monad.go
+//go:generate go fmt .
+
+// ErrEmpty is an error that FromIterator returns when the given Iterator has no value.
+var ErrEmpty = errors.New("result: empty iterator")
+
+// ErrNone is an error that FromOption returns when the given Option has no value and err is nil.
+var ErrNone = errors.New("result: None")
+
+// Ok returns a Result that consists of x.
+func Ok[T any](x T) Result[T] {
+	return Result[T]{
+		v: x,
+	}
+}
+
+// Err returns a Result that has an error err.
+// It panics if err is nil.
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		panic("result.Err: nil error")
+	}
+	return Result[T]{
+		err: err,
+	}
+}
+
+// From returns Ok(x) if err is nil, and Err(err) otherwise.
+// It can be used to wrap a function that returns (T, error), e.g. From(strconv.Atoi(s)).
+func From[T any](x T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(x)
+}
+
+// Values returns a value and an error that x has.
+// The error is nil if and only if x is Ok.
+func (x Result[T]) Values() (T, error) {
+	return x.v, x.err
+}
+
+// Lift converts a function that returns (U, error) into one that returns Result[U].
+func Lift[T, U any](fn func(T) (U, error)) func(T) Result[U] {
+	return func(x T) Result[U] {
+		return From(fn(x))
+	}
+}
+
+// FromOption returns Ok(v) if x has a value v, and Err(err) otherwise.
+// ErrNone is used instead if err is nil.
+func FromOption[T any](x option.Option[T], err error) Result[T] {
+	if !option.IsSome(x) {
+		if err == nil {
+			err = ErrNone
+		}
+		return Err[T](err)
+	}
+	return Ok(option.Unwrap(x))
+}
+
+// ToOption returns an Option that has a value of x, or None() if x is an error.
+func ToOption[T any](x Result[T]) option.Option[T] {
+	if x.err != nil {
+		return option.None[T]()
+	}
+	return option.Some(x.v)
+}
+
+// IsOk returns true if and only if x has a value.
+func IsOk[T any](x Result[T]) bool {
+	return x.err == nil
+}
+
+// Unwrap returns a value that x has.
+// It panics if x is an error.
+func Unwrap[T any](x Result[T]) T {
+	if x.err != nil {
+		panic("result.Unwrap: " + x.err.Error())
+	}
+	return x.v
+}
+
+// UnwrapErr returns an error that x has.
+// It panics if x is Ok.
+func UnwrapErr[T any](x Result[T]) error {
+	if x.err == nil {
+		panic("result.UnwrapErr: Ok")
+	}
+	return x.err
+}
+
+// UnwrapOr returns a value that x has.
+// It returns def if x is an error.
+func UnwrapOr[T any](x Result[T], def T) T {
+	if x.err != nil {
+		return def
+	}
+	return x.v
+}
+
+// UnwrapOrElse returns a value that x has.
+// It returns def(err) if x has an error err.
+func UnwrapOrElse[T any](x Result[T], def func(error) T) T {
+	if x.err != nil {
+		return def(x.err)
+	}
+	return x.v
+}
+
+// Pure returns Ok(x).
+func Pure[T any](x T) Result[T] {
+	return Ok(x)
+}
+
+// AndThen composes a monadic value x and action fn.
+// It returns the error as is if x is an error.
+func AndThen[T, U any](x Result[T], fn func(T) Result[U]) Result[U] {
+	if x.err != nil {
+		return Err[U](x.err)
+	}
+	return fn(x.v)
+}
+
+// Map applies fn to a value that x has.
+// It returns the error as is if x is an error.
+func Map[T, U any](x Result[T], fn func(T) U) Result[U] {
+	if x.err != nil {
+		return Err[U](x.err)
+	}
+	return Ok(fn(x.v))
+}
+
+// FromIterator returns a Result that has the first element in the given Iterator.
+// It returns Err(ErrEmpty) if the iterator has no value.
+func FromIterator[T any](it iterator.Iterator[T]) Result[T] {
+	if x, ok := it.Next(); ok {
+		return Ok(x)
+	}
+	return Err[T](ErrEmpty)
+}
+
+// Iter returns an Iterator that returns a single value that x has, or no value if x is an error.
+func (x Result[T]) Iter() iterator.Iterator[T] {
+	return ToOption(x).Iter()
+}
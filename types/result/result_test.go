@@ -0,0 +1,119 @@
+package result_test
+
+import (
+	"errors"
+	"github.com/genkami/dogs/types/iterator"
+	"github.com/genkami/dogs/types/option"
+	"github.com/genkami/dogs/types/result"
+	"github.com/genkami/dogs/types/slice"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+var errTest = errors.New("test error")
+
+func TestOk(t *testing.T) {
+	x := result.Ok[int](123)
+	assert.True(t, result.IsOk(x))
+	assert.Equal(t, result.Unwrap(x), 123)
+	assert.Panics(t, func() { result.UnwrapErr(x) })
+}
+
+func TestErr(t *testing.T) {
+	x := result.Err[int](errTest)
+	assert.False(t, result.IsOk(x))
+	assert.Equal(t, result.UnwrapErr(x), errTest)
+	assert.Panics(t, func() { result.Unwrap(x) })
+	assert.Panics(t, func() { result.Err[int](nil) })
+}
+
+func TestFrom(t *testing.T) {
+	v, err := result.From(strconv.Atoi("123")).Values()
+	assert.Equal(t, v, 123)
+	assert.NoError(t, err)
+
+	_, err = result.From(strconv.Atoi("abc")).Values()
+	assert.Error(t, err)
+}
+
+func TestLift(t *testing.T) {
+	subject := func(xs ...string) []result.Result[int] {
+		it := iterator.Map(slice.Slice[string](xs).Iter(), result.Lift(strconv.Atoi))
+		return slice.FromIterator(it)
+	}
+
+	actual := subject("1", "a", "3")
+	assert.Equal(t, len(actual), 3)
+	assert.Equal(t, result.Unwrap(actual[0]), 1)
+	assert.False(t, result.IsOk(actual[1]))
+	assert.Equal(t, result.Unwrap(actual[2]), 3)
+}
+
+func TestFromOption(t *testing.T) {
+	assert.Equal(t, result.Unwrap(result.FromOption(option.Some[int](123), errTest)), 123)
+	assert.Equal(t, result.UnwrapErr(result.FromOption(option.None[int](), errTest)), errTest)
+	assert.Equal(t, result.UnwrapErr(result.FromOption(option.None[int](), nil)), result.ErrNone)
+	assert.Equal(t, result.Unwrap(result.FromOption(option.Some[int](123), nil)), 123)
+}
+
+func TestToOption(t *testing.T) {
+	assert.True(t, option.Equal(result.ToOption(result.Ok[int](123)), option.Some[int](123)))
+	assert.True(t, option.Equal(result.ToOption(result.Err[int](errTest)), option.None[int]()))
+}
+
+func TestUnwrapOr(t *testing.T) {
+	assert.Equal(t, result.UnwrapOr(result.Ok[int](123), 456), 123)
+	assert.Equal(t, result.UnwrapOr(result.Err[int](errTest), 456), 456)
+}
+
+func TestUnwrapOrElse(t *testing.T) {
+	fn := func(err error) string {
+		return err.Error()
+	}
+	assert.Equal(t, result.UnwrapOrElse(result.Ok[string]("hoge"), fn), "hoge")
+	assert.Equal(t, result.UnwrapOrElse(result.Err[string](errTest), fn), "test error")
+}
+
+func TestMap(t *testing.T) {
+	double := func(x int) int { return x * 2 }
+	assert.Equal(t, result.Unwrap(result.Map(result.Ok[int](123), double)), 246)
+	assert.Equal(t, result.UnwrapErr(result.Map(result.Err[int](errTest), double)), errTest)
+}
+
+func TestAndThen(t *testing.T) {
+	parse := result.Lift(strconv.Atoi)
+	assert.Equal(t, result.Unwrap(result.AndThen(result.Ok[string]("123"), parse)), 123)
+	assert.False(t, result.IsOk(result.AndThen(result.Ok[string]("abc"), parse)))
+	assert.Equal(t, result.UnwrapErr(result.AndThen(result.Err[string](errTest), parse)), errTest)
+}
+
+func TestLiftM(t *testing.T) {
+	double := result.LiftM(func(x int) int { return x * 2 })
+	assert.Equal(t, result.Unwrap(double(result.Pure(123))), 246)
+	assert.Equal(t, result.UnwrapErr(double(result.Err[int](errTest))), errTest)
+}
+
+func TestFromIterator(t *testing.T) {
+	subject := func(xs ...int) result.Result[int] {
+		it := slice.Slice[int](xs).Iter()
+		return result.FromIterator(it)
+	}
+	assert.Equal(t, result.UnwrapErr(subject()), result.ErrEmpty)
+	assert.Equal(t, result.Unwrap(subject(1)), 1)
+	assert.Equal(t, result.Unwrap(subject(2, 3)), 2)
+}
+
+func TestIter(t *testing.T) {
+	ok := func(x int) []int {
+		it := result.Ok[int](x).Iter()
+		return ([]int)(slice.FromIterator(it))
+	}
+	err := func() []int {
+		it := result.Err[int](errTest).Iter()
+		return ([]int)(slice.FromIterator(it))
+	}
+	assert.Equal(t, err(), []int{})
+	assert.Equal(t, ok(1), []int{1})
+	assert.Equal(t, ok(2), []int{2})
+}
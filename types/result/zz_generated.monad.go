@@ -0,0 +1,28 @@
+// Code generated by gen-functions; DO NOT EDIT.
+
+package result
+
+import (
+	"github.com/genkami/dogs/classes/algebra"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/types/iterator"
+	"github.com/genkami/dogs/types/pair"
+	"iter"
+)
+
+// Some packages are unused depending on -include CLI option.
+// This prevents compile error when corresponding functions are not defined.
+var _ = (algebra.Monoid[int])(nil)
+var _ = (cmp.Ord[int])(nil)
+var _ = (iterator.Iterator[int])(nil)
+var _ = (*pair.Pair[int, int])(nil)
+var _ = (iter.Seq[int])(nil)
+
+// LiftM promotes a function fn to a monad.
+func LiftM[T, U any](fn func(T) U) func(Result[T]) Result[U] {
+	return func(mt Result[T]) Result[U] {
+		return AndThen[T, U](mt, func(t T) Result[U] {
+			return Pure(fn(t))
+		})
+	}
+}
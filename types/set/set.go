@@ -1,6 +1,14 @@
 package set
 
-import "github.com/genkami/dogs/types/iterator"
+import (
+	"github.com/genkami/dogs/classes/algebra"
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/types/iterator"
+	"sort"
+	"strings"
+)
 
 // Set is a set of type T.
 // https://golang.org/ref/spec#Comparison_operators
@@ -59,6 +67,114 @@ func Remove[T comparable](s Set[T], e T) bool {
 	return true
 }
 
+// Elems returns a slice of elements in s.
+// The order of elements is unspecified.
+func Elems[T comparable](s Set[T]) []T {
+	elems := make([]T, 0, len(s))
+	for e := range s {
+		elems = append(elems, e)
+	}
+	return elems
+}
+
+// IsSuperset returns true if and only if s is a superset of t.
+func IsSuperset[T comparable](s, t Set[T]) bool {
+	return Subset(t, s)
+}
+
+// Disjoint returns true if and only if s and t have no elements in common.
+func Disjoint[T comparable](s, t Set[T]) bool {
+	if len(t) < len(s) {
+		s, t = t, s
+	}
+	for e := range s {
+		if Has(t, e) {
+			return false
+		}
+	}
+	return true
+}
+
+// Union returns a new set that has all elements in either s or t.
+// Neither s nor t is modified.
+func Union[T comparable](s, t Set[T]) Set[T] {
+	u := make(Set[T], len(s)+len(t))
+	UnionInPlace(u, s)
+	UnionInPlace(u, t)
+	return u
+}
+
+// UnionInPlace adds all elements in t to s.
+func UnionInPlace[T comparable](s, t Set[T]) {
+	for e := range t {
+		Add(s, e)
+	}
+}
+
+// Intersection returns a new set that has all elements in both s and t.
+// Neither s nor t is modified.
+func Intersection[T comparable](s, t Set[T]) Set[T] {
+	if len(t) < len(s) {
+		s, t = t, s
+	}
+	u := Set[T]{}
+	for e := range s {
+		if Has(t, e) {
+			Add(u, e)
+		}
+	}
+	return u
+}
+
+// IntersectionInPlace removes all elements that t doesn't have from s.
+func IntersectionInPlace[T comparable](s, t Set[T]) {
+	for e := range s {
+		if !Has(t, e) {
+			delete(s, e)
+		}
+	}
+}
+
+// Difference returns a new set that has all elements in s but not in t.
+// Neither s nor t is modified.
+func Difference[T comparable](s, t Set[T]) Set[T] {
+	u := Set[T]{}
+	for e := range s {
+		if !Has(t, e) {
+			Add(u, e)
+		}
+	}
+	return u
+}
+
+// DifferenceInPlace removes all elements in t from s.
+func DifferenceInPlace[T comparable](s, t Set[T]) {
+	for e := range t {
+		delete(s, e)
+	}
+}
+
+// SymmetricDifference returns a new set that has all elements in exactly one of s and t.
+// Neither s nor t is modified.
+func SymmetricDifference[T comparable](s, t Set[T]) Set[T] {
+	u := Difference(s, t)
+	for e := range t {
+		if !Has(s, e) {
+			Add(u, e)
+		}
+	}
+	return u
+}
+
+// SymmetricDifferenceInPlace replaces s with the symmetric difference of s and t.
+func SymmetricDifferenceInPlace[T comparable](s, t Set[T]) {
+	for e := range t {
+		if !Remove(s, e) {
+			Add(s, e)
+		}
+	}
+}
+
 // FromIterator returns a Set from given Iterator.
 func FromIterator[T comparable](it iterator.Iterator[T]) Set[T] {
 	s := Set[T]{}
@@ -74,10 +190,7 @@ func FromIterator[T comparable](it iterator.Iterator[T]) Set[T] {
 
 // Iter returns an Iterator that iterates over s.
 func (s Set[T]) Iter() iterator.Iterator[T] {
-	keys := make([]T, 0, len(s))
-	for k, _ := range s {
-		keys = append(keys, k)
-	}
+	keys := Elems(s)
 	return iterator.Unfold[int, T](0, func(i int) (int, T, bool) {
 		if len(keys) <= i {
 			var zero T
@@ -87,8 +200,89 @@ func (s Set[T]) Iter() iterator.Iterator[T] {
 	})
 }
 
-// TODO: DeriveEq[T] Eq[Set[T]]
-// TODO: Elems[T](s Set[T]) []T
-// TODO: Merge[T](s, t Set[T]) Set[T]
-// TODO: Union[T](s, t Set[T]) Set[T]
-// TODO: Intersection[T](s, t Set[T]) Set[T]
+// DeriveEq derives Eq[Set[T]].
+// Two sets are equal if they have exactly the same elements.
+func DeriveEq[T comparable]() cmp.Eq[Set[T]] {
+	return &cmp.DefaultEq[Set[T]]{
+		EqualImpl: Equal[T],
+	}
+}
+
+// DeriveLattice derives Lattice[Set[T]] whose Join is Union and Meet is Intersection.
+func DeriveLattice[T comparable]() algebra.Lattice[Set[T]] {
+	return &algebra.DefaultLattice[Set[T]]{
+		JoinImpl: Union[T],
+		MeetImpl: Intersection[T],
+	}
+}
+
+// DeriveUnionMonoid derives Monoid[Set[T]] whose Combine is Union and Empty is the empty set.
+func DeriveUnionMonoid[T comparable]() algebra.Monoid[Set[T]] {
+	return &algebra.DefaultMonoid[Set[T]]{
+		Semigroup: &algebra.DefaultSemigroup[Set[T]]{
+			CombineImpl: Union[T],
+		},
+		EmptyImpl: func() Set[T] {
+			return New[T]()
+		},
+	}
+}
+
+// DeriveIntersectionSemigroup derives Semigroup[Set[T]] whose Combine is Intersection.
+// Note that it is not a Monoid since there is no set of all values of T in general.
+func DeriveIntersectionSemigroup[T comparable]() algebra.Semigroup[Set[T]] {
+	return &algebra.DefaultSemigroup[Set[T]]{
+		CombineImpl: Intersection[T],
+	}
+}
+
+// DeriveDebug derives Debug[Set[T]] from Debug[T].
+// It formats values as `Set{<elem>, <elem>, ...}`, where elements are sorted by their formatted representations.
+func DeriveDebug[T comparable](d debug.Debug[T]) debug.Debug[Set[T]] {
+	return &debug.DefaultDebug[Set[T]]{
+		DebugFmtImpl: func(s Set[T]) string {
+			elems := make([]string, 0, len(s))
+			for e := range s {
+				elems = append(elems, d.DebugFmt(e))
+			}
+			sort.Strings(elems)
+			return "Set{" + strings.Join(elems, ", ") + "}"
+		},
+	}
+}
+
+// DeriveSortedDebug derives Debug[Set[T]] from Debug[T] and Ord[T].
+// It is the same as DeriveDebug except that elements are sorted with respect to ord.
+func DeriveSortedDebug[T comparable](d debug.Debug[T], ord cmp.Ord[T]) debug.Debug[Set[T]] {
+	return &debug.DefaultDebug[Set[T]]{
+		DebugFmtImpl: func(s Set[T]) string {
+			elems := make([]T, 0, len(s))
+			for e := range s {
+				elems = append(elems, e)
+			}
+			sort.Slice(elems, func(i, j int) bool {
+				return ord.Lt(elems[i], elems[j])
+			})
+			strs := make([]string, 0, len(elems))
+			for _, e := range elems {
+				strs = append(strs, d.DebugFmt(e))
+			}
+			return "Set{" + strings.Join(strs, ", ") + "}"
+		},
+	}
+}
+
+// DeriveArbitrary derives Arbitrary[Set[T]] from Arbitrary[T] and Ord[T].
+// Elements are sorted with respect to ord before shrinking so that shrinking is deterministic.
+func DeriveArbitrary[T comparable](a arbitrary.Arbitrary[T], ord cmp.Ord[T]) arbitrary.Arbitrary[Set[T]] {
+	return arbitrary.Convert(arbitrary.SliceOf(a),
+		func(xs []T) Set[T] { return New(xs...) },
+		func(s Set[T]) []T {
+			elems := Elems(s)
+			sort.Slice(elems, func(i, j int) bool {
+				return ord.Lt(elems[i], elems[j])
+			})
+			return elems
+		},
+	)
+}
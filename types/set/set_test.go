@@ -1,9 +1,16 @@
 package set_test
 
 import (
+	"github.com/genkami/dogs/classes/algebra/algebratest"
+	"github.com/genkami/dogs/classes/arbitrary"
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/cmp/cmptest"
+	"github.com/genkami/dogs/classes/debug"
 	"github.com/genkami/dogs/types/set"
 	"github.com/genkami/dogs/types/slice"
 	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"slices"
 	"testing"
 )
 
@@ -101,3 +108,146 @@ func TestSet_Iter(t *testing.T) {
 	assert.ElementsMatch(t, subject(1, 2), []int{1, 2})
 	assert.ElementsMatch(t, subject(1, 2, 3), []int{1, 2, 3})
 }
+
+func TestSet_All(t *testing.T) {
+	subject := func(xs ...int) []int {
+		return slices.Sorted(set.New[int](xs...).All())
+	}
+
+	assert.Equal(t, subject(), []int(nil))
+	assert.Equal(t, subject(1), []int{1})
+	assert.Equal(t, subject(3, 1, 2), []int{1, 2, 3})
+}
+
+func TestDeriveDebug(t *testing.T) {
+	d := set.DeriveDebug(debug.DeriveDebug[int]())
+	assert.Equal(t, d.DebugFmt(set.New[int]()), "Set{}")
+	assert.Equal(t, d.DebugFmt(set.New[int](1)), "Set{1}")
+	assert.Equal(t, d.DebugFmt(set.New[int](3, 10, 2)), "Set{10, 2, 3}")
+}
+
+func TestDeriveSortedDebug(t *testing.T) {
+	d := set.DeriveSortedDebug(debug.DeriveDebug[int](), cmp.DeriveOrd[int]())
+	assert.Equal(t, d.DebugFmt(set.New[int]()), "Set{}")
+	assert.Equal(t, d.DebugFmt(set.New[int](1)), "Set{1}")
+	assert.Equal(t, d.DebugFmt(set.New[int](3, 10, 2)), "Set{2, 3, 10}")
+}
+
+func TestDeriveArbitrary(t *testing.T) {
+	a := set.DeriveArbitrary(arbitrary.Int[int](), cmp.DeriveOrd[int]())
+	choose := func() set.Set[int] {
+		return a.Choose(rand.New(rand.NewSource(42)))
+	}
+	assert.True(t, set.Equal(choose(), choose()))
+	assert.Empty(t, a.Shrink(set.New[int]()))
+	assert.Equal(t, a.Shrink(set.New[int](1)), []set.Set[int]{set.New[int](), set.New[int](0)})
+	s := set.New(1, 2, 3, 4, 5)
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, a.Shrink(s), a.Shrink(s), "shrinking should be deterministic")
+	}
+}
+
+func TestDeriveEq(t *testing.T) {
+	eq := set.DeriveEq[int]()
+	assert.True(t, eq.Equal(set.New[int](), set.New[int]()))
+	assert.True(t, eq.Equal(set.New(1, 2), set.New(2, 1)))
+	assert.False(t, eq.Equal(set.New(1, 2), set.New(1, 3)))
+	assert.False(t, eq.Equal(set.New(1, 2), set.New(1)))
+	cmptest.AssertEq(t, eq, set.DeriveArbitrary(arbitrary.Int[int](), cmp.DeriveOrd[int]()), set.DeriveDebug(debug.DeriveDebug[int]()))
+}
+
+func TestDeriveLattice(t *testing.T) {
+	l := set.DeriveLattice[int]()
+	s, u := set.New(1, 2, 3), set.New(2, 3, 4)
+	assert.Equal(t, l.Join(s, u), set.New(1, 2, 3, 4))
+	assert.Equal(t, l.Meet(s, u), set.New(2, 3))
+	assert.Equal(t, s, set.New(1, 2, 3), "the arguments should not be modified")
+	algebratest.AssertLattice(t, l,
+		set.DeriveEq[int](),
+		set.DeriveArbitrary(arbitrary.Int[int](), cmp.DeriveOrd[int]()),
+		set.DeriveDebug(debug.DeriveDebug[int]()),
+	)
+}
+
+func TestElems(t *testing.T) {
+	elems := set.Elems(set.New(1, 2, 3))
+	slices.Sort(elems)
+	assert.Equal(t, elems, []int{1, 2, 3})
+	assert.Equal(t, set.Elems(set.New[int]()), []int{})
+}
+
+func TestIsSuperset(t *testing.T) {
+	assert.True(t, set.IsSuperset(set.New(1, 2), set.New(1)))
+	assert.True(t, set.IsSuperset(set.New(1, 2), set.New[int]()))
+	assert.False(t, set.IsSuperset(set.New(1), set.New(1, 2)))
+}
+
+func TestDisjoint(t *testing.T) {
+	assert.True(t, set.Disjoint(set.New(1, 2), set.New(3)))
+	assert.True(t, set.Disjoint(set.New[int](), set.New(3)))
+	assert.False(t, set.Disjoint(set.New(1, 2), set.New(2, 3, 4)))
+}
+
+func TestUnion(t *testing.T) {
+	s, u := set.New(1, 2), set.New(2, 3)
+	assert.Equal(t, set.Union(s, u), set.New(1, 2, 3))
+	assert.Equal(t, s, set.New(1, 2))
+	assert.Equal(t, u, set.New(2, 3))
+
+	set.UnionInPlace(s, u)
+	assert.Equal(t, s, set.New(1, 2, 3))
+	assert.Equal(t, u, set.New(2, 3))
+}
+
+func TestIntersection(t *testing.T) {
+	s, u := set.New(1, 2, 3), set.New(2, 3, 4)
+	assert.Equal(t, set.Intersection(s, u), set.New(2, 3))
+	assert.Equal(t, set.Intersection(s, set.New[int]()), set.New[int]())
+	assert.Equal(t, s, set.New(1, 2, 3))
+
+	set.IntersectionInPlace(s, u)
+	assert.Equal(t, s, set.New(2, 3))
+	assert.Equal(t, u, set.New(2, 3, 4))
+}
+
+func TestDifference(t *testing.T) {
+	s, u := set.New(1, 2, 3), set.New(2, 3, 4)
+	assert.Equal(t, set.Difference(s, u), set.New(1))
+	assert.Equal(t, set.Difference(u, s), set.New(4))
+	assert.Equal(t, s, set.New(1, 2, 3))
+
+	set.DifferenceInPlace(s, u)
+	assert.Equal(t, s, set.New(1))
+	assert.Equal(t, u, set.New(2, 3, 4))
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	s, u := set.New(1, 2, 3), set.New(2, 3, 4)
+	assert.Equal(t, set.SymmetricDifference(s, u), set.New(1, 4))
+	assert.Equal(t, s, set.New(1, 2, 3))
+
+	set.SymmetricDifferenceInPlace(s, u)
+	assert.Equal(t, s, set.New(1, 4))
+	assert.Equal(t, u, set.New(2, 3, 4))
+}
+
+func TestDeriveUnionMonoid(t *testing.T) {
+	m := set.DeriveUnionMonoid[int]()
+	assert.Equal(t, m.Combine(set.New(1), set.New(2)), set.New(1, 2))
+	assert.Equal(t, m.Empty(), set.New[int]())
+	algebratest.AssertMonoid(t, m,
+		set.DeriveEq[int](),
+		set.DeriveArbitrary(arbitrary.Int[int](), cmp.DeriveOrd[int]()),
+		set.DeriveDebug(debug.DeriveDebug[int]()),
+	)
+}
+
+func TestDeriveIntersectionSemigroup(t *testing.T) {
+	s := set.DeriveIntersectionSemigroup[int]()
+	assert.Equal(t, s.Combine(set.New(1, 2), set.New(2, 3)), set.New(2))
+	algebratest.AssertSemigroup(t, s,
+		set.DeriveEq[int](),
+		set.DeriveArbitrary(arbitrary.Int[int](), cmp.DeriveOrd[int]()),
+		set.DeriveDebug(debug.DeriveDebug[int]()),
+	)
+}
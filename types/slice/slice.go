@@ -1,9 +1,13 @@
 package slice
 
 import (
+	"github.com/genkami/dogs/classes/arbitrary"
 	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/classes/hash"
 	"github.com/genkami/dogs/types/iterator"
 	"sort"
+	"strings"
 )
 
 // Slice is a slice with extra methods.
@@ -51,3 +55,71 @@ func Sort[T any](xs Slice[T], o cmp.Ord[T]) {
 		return o.Lt(xs[i], xs[j])
 	})
 }
+
+// DeriveEq derives Eq[Slice[T]] from Eq[T].
+// Two slices are equal if they have the same length and their elements are pairwise equal in terms of eq.
+func DeriveEq[T any](eq cmp.Eq[T]) cmp.Eq[Slice[T]] {
+	return &cmp.DefaultEq[Slice[T]]{
+		EqualImpl: func(xs, ys Slice[T]) bool {
+			if len(xs) != len(ys) {
+				return false
+			}
+			for i := range xs {
+				if !eq.Equal(xs[i], ys[i]) {
+					return false
+				}
+			}
+			return true
+		},
+	}
+}
+
+// DeriveOrd derives Ord[Slice[T]] from Ord[T].
+// Slices are compared lexicographically, that is, they are compared by their first elements that differ,
+// and a slice is less than another one if the former is a prefix of the latter.
+func DeriveOrd[T any](ord cmp.Ord[T]) cmp.Ord[Slice[T]] {
+	return &cmp.DefaultOrd[Slice[T]]{
+		CompareImpl: func(xs, ys Slice[T]) cmp.Ordering {
+			for i := 0; i < len(xs) && i < len(ys); i++ {
+				if result := ord.Compare(xs[i], ys[i]); result != cmp.EQ {
+					return result
+				}
+			}
+			return cmp.FromInt(len(xs) - len(ys))
+		},
+	}
+}
+
+// DeriveHash derives Hash[Slice[T]] from Hash[T].
+func DeriveHash[T any](h hash.Hash[T]) hash.Hash[Slice[T]] {
+	var eq cmp.Eq[T] = h
+	return &hash.DefaultHash[Slice[T]]{
+		Eq: DeriveEq(eq),
+		HashImpl: func(xs Slice[T]) uint64 {
+			acc := hash.Empty
+			for _, x := range xs {
+				acc = hash.Combine(acc, h.Hash(x))
+			}
+			return acc
+		},
+	}
+}
+
+// DeriveDebug derives Debug[Slice[T]] from Debug[T].
+// It formats values as `Slice[<elem>, <elem>, ...]`.
+func DeriveDebug[T any](d debug.Debug[T]) debug.Debug[Slice[T]] {
+	return &debug.DefaultDebug[Slice[T]]{
+		DebugFmtImpl: func(xs Slice[T]) string {
+			elems := Map[T, string](xs, d.DebugFmt)
+			return "Slice[" + strings.Join(elems, ", ") + "]"
+		},
+	}
+}
+
+// DeriveArbitrary derives Arbitrary[Slice[T]] from Arbitrary[T].
+func DeriveArbitrary[T any](a arbitrary.Arbitrary[T]) arbitrary.Arbitrary[Slice[T]] {
+	return arbitrary.Convert(arbitrary.SliceOf(a),
+		func(xs []T) Slice[T] { return Slice[T](xs) },
+		func(xs Slice[T]) []T { return []T(xs) },
+	)
+}
@@ -1,10 +1,15 @@
 package slice_test
 
 import (
+	"github.com/genkami/dogs/classes/arbitrary"
 	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/cmp/cmptest"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/classes/hash"
 	"github.com/genkami/dogs/types/iterator"
 	"github.com/genkami/dogs/types/slice"
 	"github.com/stretchr/testify/assert"
+	"math/rand"
 	"testing"
 )
 
@@ -68,3 +73,96 @@ func TestSlice_Sort(t *testing.T) {
 
 	assert.Equal(t, subject([]int{3, 5, 2, 1, 4}), []int{1, 2, 3, 4, 5})
 }
+
+func TestSlice_All(t *testing.T) {
+	subject := func(xs []int) []int {
+		actual := []int{}
+		for x := range slice.Slice[int](xs).All() {
+			actual = append(actual, x)
+		}
+		return actual
+	}
+
+	assert.Equal(t, subject([]int{}), []int{})
+	assert.Equal(t, subject([]int{1}), []int{1})
+	assert.Equal(t, subject([]int{1, 2, 3}), []int{1, 2, 3})
+}
+
+func TestDeriveDebug(t *testing.T) {
+	d := slice.DeriveDebug(debug.DeriveDebug[string]())
+	assert.Equal(t, d.DebugFmt(slice.Slice[string]{}), "Slice[]")
+	assert.Equal(t, d.DebugFmt(slice.Slice[string]{"a"}), `Slice["a"]`)
+	assert.Equal(t, d.DebugFmt(slice.Slice[string]{"a", "b", "c"}), `Slice["a", "b", "c"]`)
+}
+
+func TestDeriveArbitrary(t *testing.T) {
+	a := slice.DeriveArbitrary(arbitrary.Int[int]())
+	choose := func() slice.Slice[int] {
+		return a.Choose(rand.New(rand.NewSource(42)))
+	}
+	assert.Equal(t, choose(), choose())
+	assert.Equal(t, a.Shrink(slice.Slice[int]{1}), []slice.Slice[int]{{}, {0}})
+}
+
+func TestDeriveEq(t *testing.T) {
+	eq := slice.DeriveEq(cmp.DeriveEq[int]())
+	assert.True(t, eq.Equal(nil, slice.Slice[int]{}))
+	assert.True(t, eq.Equal(slice.Slice[int]{1, 2}, slice.Slice[int]{1, 2}))
+	assert.False(t, eq.Equal(slice.Slice[int]{1, 2}, slice.Slice[int]{1, 3}))
+	assert.False(t, eq.Equal(slice.Slice[int]{1, 2}, slice.Slice[int]{1, 2, 3}))
+	cmptest.AssertEq(t, eq, slice.DeriveArbitrary(arbitrary.Int[int]()), slice.DeriveDebug(debug.DeriveDebug[int]()))
+}
+
+func TestDeriveOrd(t *testing.T) {
+	ord := slice.DeriveOrd(cmp.DeriveOrd[int]())
+	assert.Equal(t, ord.Compare(nil, slice.Slice[int]{}), cmp.EQ)
+	assert.Equal(t, ord.Compare(slice.Slice[int]{1, 2}, slice.Slice[int]{1, 2}), cmp.EQ)
+	assert.Equal(t, ord.Compare(slice.Slice[int]{1, 2}, slice.Slice[int]{1, 3}), cmp.LT)
+	assert.Equal(t, ord.Compare(slice.Slice[int]{2}, slice.Slice[int]{1, 3}), cmp.GT)
+	assert.Equal(t, ord.Compare(slice.Slice[int]{1, 2}, slice.Slice[int]{1}), cmp.GT)
+	assert.Equal(t, ord.Compare(slice.Slice[int]{}, slice.Slice[int]{1}), cmp.LT)
+	cmptest.AssertOrd(t, ord, slice.DeriveArbitrary(arbitrary.Int[int]()), slice.DeriveDebug(debug.DeriveDebug[int]()))
+}
+
+func TestDeriveHash(t *testing.T) {
+	h := slice.DeriveHash(hash.DeriveHash[int]())
+	assert.True(t, h.Equal(slice.Slice[int]{1, 2}, slice.Slice[int]{1, 2}))
+	assert.False(t, h.Equal(slice.Slice[int]{1, 2}, slice.Slice[int]{2, 1}))
+	assert.Equal(t, h.Hash(nil), h.Hash(slice.Slice[int]{}))
+	assert.Equal(t, h.Hash(slice.Slice[int]{1, 2}), h.Hash(slice.Slice[int]{1, 2}))
+	assert.NotEqual(t, h.Hash(slice.Slice[int]{1, 2}), h.Hash(slice.Slice[int]{2, 1}))
+	assert.NotEqual(t, h.Hash(slice.Slice[int]{1}), h.Hash(slice.Slice[int]{1, 1}))
+}
+
+func TestSlicing(t *testing.T) {
+	xs := slice.Slice[int]{1, 2, 3, 4, 5}
+	isSmall := func(x int) bool { return x < 3 }
+	assert.Equal(t, slice.Take(xs, 2), slice.Slice[int]{1, 2})
+	assert.Equal(t, slice.Drop(xs, 2), slice.Slice[int]{3, 4, 5})
+	assert.Equal(t, slice.TakeWhile(xs, isSmall), slice.Slice[int]{1, 2})
+	assert.Equal(t, slice.DropWhile(xs, isSmall), slice.Slice[int]{3, 4, 5})
+	assert.Equal(t, slice.StepBy(xs, 2), slice.Slice[int]{1, 3, 5})
+
+	x, ok := slice.Nth(xs, 1)
+	assert.True(t, ok)
+	assert.Equal(t, x, 2)
+
+	_, ok = slice.Nth(xs, -1)
+	assert.False(t, ok)
+
+	x, ok = slice.Last(xs)
+	assert.True(t, ok)
+	assert.Equal(t, x, 5)
+}
+
+func TestCount(t *testing.T) {
+	assert.Equal(t, slice.Count(slice.Slice[int]{}), 0)
+	assert.Equal(t, slice.Count(slice.Slice[int]{1, 2, 3}), 3)
+}
+
+func TestAnyAndAll(t *testing.T) {
+	isEven := func(x int) bool { return x%2 == 0 }
+	assert.True(t, slice.Any(slice.Slice[int]{1, 2, 3}, isEven))
+	assert.False(t, slice.All(slice.Slice[int]{1, 2, 3}, isEven))
+	assert.True(t, slice.All(slice.Slice[int]{2, 4}, isEven))
+}
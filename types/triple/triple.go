@@ -0,0 +1,53 @@
+package triple
+
+import (
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/debug"
+)
+
+// Triple is a tuple of three values.
+type Triple[T, U, V any] struct {
+	First  T
+	Second U
+	Third  V
+}
+
+// Values returns its values.
+func (p Triple[T, U, V]) Values() (T, U, V) {
+	return p.First, p.Second, p.Third
+}
+
+// DeriveEq derives Eq[Triple[T, U, V]] from Eq[T], Eq[U] and Eq[V].
+func DeriveEq[T, U, V any](et cmp.Eq[T], eu cmp.Eq[U], ev cmp.Eq[V]) cmp.Eq[Triple[T, U, V]] {
+	return &cmp.DefaultEq[Triple[T, U, V]]{
+		EqualImpl: func(p, q Triple[T, U, V]) bool {
+			return et.Equal(p.First, q.First) && eu.Equal(p.Second, q.Second) && ev.Equal(p.Third, q.Third)
+		},
+	}
+}
+
+// DeriveOrd derives Ord[Triple[T, U, V]] from Ord[T], Ord[U] and Ord[V].
+// Triples are compared lexicographically.
+func DeriveOrd[T, U, V any](ot cmp.Ord[T], ou cmp.Ord[U], ov cmp.Ord[V]) cmp.Ord[Triple[T, U, V]] {
+	return &cmp.DefaultOrd[Triple[T, U, V]]{
+		CompareImpl: func(p, q Triple[T, U, V]) cmp.Ordering {
+			if o := ot.Compare(p.First, q.First); o != cmp.EQ {
+				return o
+			}
+			if o := ou.Compare(p.Second, q.Second); o != cmp.EQ {
+				return o
+			}
+			return ov.Compare(p.Third, q.Third)
+		},
+	}
+}
+
+// DeriveDebug derives Debug[Triple[T, U, V]] from Debug[T], Debug[U] and Debug[V].
+// It formats values as `Triple(<first>, <second>, <third>)`.
+func DeriveDebug[T, U, V any](dt debug.Debug[T], du debug.Debug[U], dv debug.Debug[V]) debug.Debug[Triple[T, U, V]] {
+	return &debug.DefaultDebug[Triple[T, U, V]]{
+		DebugFmtImpl: func(p Triple[T, U, V]) string {
+			return "Triple(" + dt.DebugFmt(p.First) + ", " + du.DebugFmt(p.Second) + ", " + dv.DebugFmt(p.Third) + ")"
+		},
+	}
+}
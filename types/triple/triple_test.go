@@ -0,0 +1,41 @@
+package triple_test
+
+import (
+	"github.com/genkami/dogs/classes/cmp"
+	"github.com/genkami/dogs/classes/debug"
+	"github.com/genkami/dogs/types/triple"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type Triple = triple.Triple[int, string, bool]
+
+func TestTriple_Values(t *testing.T) {
+	a, b, c := Triple{First: 123, Second: "abc", Third: true}.Values()
+	assert.Equal(t, a, 123)
+	assert.Equal(t, b, "abc")
+	assert.Equal(t, c, true)
+}
+
+func TestDeriveEq(t *testing.T) {
+	e := triple.DeriveEq(cmp.DeriveEq[int](), cmp.DeriveEq[string](), cmp.DeriveEq[bool]())
+	x := Triple{First: 1, Second: "hoge", Third: true}
+	assert.True(t, e.Equal(x, Triple{First: 1, Second: "hoge", Third: true}))
+	assert.False(t, e.Equal(x, Triple{First: 2, Second: "hoge", Third: true}))
+	assert.False(t, e.Equal(x, Triple{First: 1, Second: "fuga", Third: true}))
+	assert.False(t, e.Equal(x, Triple{First: 1, Second: "hoge", Third: false}))
+}
+
+func TestDeriveOrd(t *testing.T) {
+	o := triple.DeriveOrd(cmp.DeriveOrd[int](), cmp.DeriveOrd[string](), cmp.DeriveOrd[int]())
+	type T = triple.Triple[int, string, int]
+	assert.Equal(t, o.Compare(T{First: 1, Second: "b", Third: 1}, T{First: 1, Second: "b", Third: 1}), cmp.EQ)
+	assert.Equal(t, o.Compare(T{First: 1, Second: "b", Third: 1}, T{First: 2, Second: "a", Third: 0}), cmp.LT)
+	assert.Equal(t, o.Compare(T{First: 1, Second: "b", Third: 1}, T{First: 1, Second: "a", Third: 2}), cmp.GT)
+	assert.Equal(t, o.Compare(T{First: 1, Second: "b", Third: 1}, T{First: 1, Second: "b", Third: 2}), cmp.LT)
+}
+
+func TestDeriveDebug(t *testing.T) {
+	d := triple.DeriveDebug(debug.DeriveDebug[int](), debug.DeriveDebug[string](), debug.DeriveDebug[bool]())
+	assert.Equal(t, d.DebugFmt(Triple{First: 1, Second: "a", Third: true}), `Triple(1, "a", true)`)
+}